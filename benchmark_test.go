@@ -1,6 +1,9 @@
 package uuid
 
-import "testing"
+import (
+	"bytes"
+	"testing"
+)
 
 func BenchmarkV1(b *testing.B) {
 	for b.Loop() {
@@ -43,3 +46,42 @@ func BenchmarkV8(b *testing.B) {
 		NewV8([]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF, 0x01, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF})
 	}
 }
+
+func BenchmarkV8SHA256(b *testing.B) {
+	for b.Loop() {
+		NewV8SHA256(NamespaceDNS(), "example.com")
+	}
+}
+
+func BenchmarkAppendBase62(b *testing.B) {
+	id := NewV4()
+	buf := make([]byte, 0, 22)
+	for b.Loop() {
+		id.AppendBase62(buf)
+	}
+}
+
+func BenchmarkFindAll(b *testing.B) {
+	line := []byte("2024-05-01T12:00:00Z INFO request completed request_id=017f22e2-79b0-7cc3-98c4-dc0c0c07398f user=c232ab00941411ecb3c89f6bdeced846 status=200\n")
+	text := bytes.Repeat(line, 100)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		FindAll(text)
+	}
+}
+
+func BenchmarkEncrypt(b *testing.B) {
+	e, _ := NewEncrypter(7, 0, map[int][]byte{0: []byte("0123456789abcdef")})
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	for b.Loop() {
+		e.Encrypt(id)
+	}
+}
+
+func BenchmarkDecrypt(b *testing.B) {
+	e, _ := NewEncrypter(7, 0, map[int][]byte{0: []byte("0123456789abcdef")})
+	id, _ := e.Encrypt(UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F})
+	for b.Loop() {
+		e.Decrypt(id)
+	}
+}
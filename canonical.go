@@ -0,0 +1,560 @@
+package uuid
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// maxSafeInteger is the largest integer that can be represented exactly as an IEEE 754 double.
+const maxSafeInteger = 1 << 53
+
+// NewV5JSON returns a new UUID based on the SHA-1 hash of the provided namespace and the canonical JSON encoding of v.
+// See CanonicalJSON for details on how v is encoded.
+func NewV5JSON(ns UUID, v any) (UUID, error) {
+	data, err := CanonicalJSON(v)
+	if err != nil {
+		return UUID{}, err
+	}
+	return newHashed(sha1.New(), 5, ns, data), nil
+}
+
+// NewV8SHA256JSON returns a new name-based UUIDv8 based on the SHA-256 hash of the provided namespace and the canonical JSON encoding of v.
+// See CanonicalJSON for details on how v is encoded.
+func NewV8SHA256JSON(ns UUID, v any) (UUID, error) {
+	data, err := CanonicalJSON(v)
+	if err != nil {
+		return UUID{}, err
+	}
+	return newHashed(sha256.New(), 8, ns, data), nil
+}
+
+// CanonicalJSON returns the JSON encoding of v canonicalized according to RFC 8785 (JSON Canonicalization Scheme).
+// Object keys are sorted, numbers are formatted as IEEE 754 doubles and strings use minimal escaping,
+// so equal values always produce identical output regardless of key order or number formatting.
+//
+// Values are converted in the same way as by encoding/json, including support for json.Marshaler and encoding.TextMarshaler.
+// An existing JSON document can be canonicalized by passing it as json.RawMessage.
+// Struct fields are named, omitted and quoted according to their json tag.
+// Fields tagged with `uuid:"-"` are excluded from the canonical encoding while still being part of the regular JSON encoding.
+//
+// Integers outside of ±2^53 cannot be represented exactly and result in an error, including those in a json.Number or a JSON document,
+// as do NaN, infinities, invalid UTF-8 and values containing themselves.
+func CanonicalJSON(v any) ([]byte, error) {
+	tree, err := canonicalValue(reflect.ValueOf(v), make(map[cycleKey]bool))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, tree); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonNumberType    = reflect.TypeFor[json.Number]()
+)
+
+// canonicalValue converts v to a tree made up of nil, bool, float32, float64, json.Number, string, []any and map[string]any.
+func canonicalValue(v reflect.Value, path map[cycleKey]bool) (any, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if v.Kind() != reflect.Pointer && v.CanAddr() {
+		if pt := reflect.PointerTo(v.Type()); pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType) {
+			v = v.Addr()
+		}
+	}
+	if v.Type().Implements(jsonMarshalerType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil, nil
+		}
+		data, err := v.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return decodeCanonical(data)
+	}
+	if v.Type().Implements(textMarshalerType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil, nil
+		}
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return string(text), nil
+	}
+	if v.Type() == jsonNumberType {
+		if v.String() == "" {
+			return json.Number("0"), nil // encoding/json encodes the empty number as 0
+		}
+		return json.Number(v.String()), nil
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if !v.IsNil() {
+			key := cycleKey{v.Type(), v.Pointer(), 0}
+			if v.Kind() == reflect.Slice {
+				key.len = v.Len()
+			}
+			if path[key] {
+				return nil, fmt.Errorf("encountered a cycle via %s in canonical JSON", v.Type())
+			}
+			path[key] = true
+			defer delete(path, key)
+		}
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return canonicalValue(v.Elem(), path)
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		if i > maxSafeInteger || i < -maxSafeInteger {
+			return nil, fmt.Errorf("integer %d cannot be represented exactly in canonical JSON", i)
+		}
+		return float64(i), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := v.Uint()
+		if u > maxSafeInteger {
+			return nil, fmt.Errorf("integer %d cannot be represented exactly in canonical JSON", u)
+		}
+		return float64(u), nil
+	case reflect.Float32:
+		return float32(v.Float()), nil
+	case reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+		return canonicalArray(v, path)
+	case reflect.Array:
+		return canonicalArray(v, path)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return canonicalMap(v, path)
+	case reflect.Struct:
+		return canonicalStruct(v, path)
+	default:
+		return nil, fmt.Errorf("unsupported type %s for canonical JSON", v.Type())
+	}
+}
+
+// cycleKey identifies a pointer, map or slice on the path to the value being converted
+type cycleKey struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+func canonicalArray(v reflect.Value, path map[cycleKey]bool) (any, error) {
+	arr := make([]any, v.Len())
+	for i := range arr {
+		elem, err := canonicalValue(v.Index(i), path)
+		if err != nil {
+			return nil, err
+		}
+		arr[i] = elem
+	}
+	return arr, nil
+}
+
+func canonicalMap(v reflect.Value, path map[cycleKey]bool) (any, error) {
+	obj := make(map[string]any, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := canonicalKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		val, err := canonicalValue(iter.Value(), path)
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = val
+	}
+	return obj, nil
+}
+
+// canonicalKey converts a map key to a string following the rules of encoding/json.
+func canonicalKey(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type %s for canonical JSON", k.Type())
+	}
+}
+
+// canonicalStruct converts the fields of v to an object.
+func canonicalStruct(v reflect.Value, path map[cycleKey]bool) (any, error) {
+	obj := make(map[string]any)
+fields:
+	for _, field := range structFields(v.Type()) {
+		fv := v
+		for _, i := range field.index {
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue fields // fields of a nil embedded struct are omitted
+				}
+				fv = fv.Elem()
+			}
+			fv = fv.Field(i)
+		}
+		if slices.Contains(strings.Split(field.opts, ","), "omitempty") && isEmptyValue(fv) {
+			continue
+		}
+		val, err := canonicalValue(fv, path)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil && slices.Contains(strings.Split(field.opts, ","), "string") && isQuotable(fv.Type()) {
+			var buf bytes.Buffer
+			if err := writeCanonical(&buf, val); err != nil {
+				return nil, err
+			}
+			val = buf.String()
+		}
+		obj[field.name] = val
+	}
+	return obj, nil
+}
+
+// structField is a field of a struct type included in its JSON encoding
+type structField struct {
+	name   string
+	index  []int // Field indices from the outer struct, following embedded structs
+	tagged bool  // Whether the name is given by a json tag
+	opts   string
+}
+
+// structFields returns the fields of t included in its JSON encoding.
+// Fields of embedded structs are promoted following the rules of encoding/json:
+// of the fields with the same name the shallowest one is used, at the same depth a tagged field is preferred over untagged ones,
+// and if that still leaves more than one field, none of them is included.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	visited := map[reflect.Type]bool{}
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+	next := []embedded{{t, nil}}
+	for len(next) > 0 {
+		current := next
+		next = nil
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			for i := range e.typ.NumField() {
+				sf := e.typ.Field(i)
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if !sf.IsExported() && (!sf.Anonymous || ft.Kind() != reflect.Struct) {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" || sf.Tag.Get("uuid") == "-" {
+					continue
+				}
+				name, opts, _ := strings.Cut(tag, ",")
+				index := append(slices.Clone(e.index), i)
+				if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+					next = append(next, embedded{ft, index})
+					continue
+				}
+				fields = append(fields, structField{cmp.Or(name, sf.Name), index, name != "", opts})
+			}
+		}
+		// Types are only marked after a complete depth, so a struct embedded twice at the same depth yields conflicting fields
+		for _, e := range current {
+			visited[e.typ] = true
+		}
+	}
+	slices.SortStableFunc(fields, func(a, b structField) int {
+		if c := cmp.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(len(a.index), len(b.index)); c != 0 {
+			return c
+		}
+		if a.tagged != b.tagged {
+			if a.tagged {
+				return -1
+			}
+			return 1
+		}
+		return 0
+	})
+	dominant := fields[:0]
+	for i := 0; i < len(fields); {
+		n := 1
+		for i+n < len(fields) && fields[i+n].name == fields[i].name {
+			n++
+		}
+		f := fields[i]
+		if n == 1 || len(fields[i+1].index) > len(f.index) || f.tagged && !fields[i+1].tagged {
+			dominant = append(dominant, f)
+		}
+		i += n
+	}
+	return dominant
+}
+
+// isQuotable reports whether the string option of a json tag applies to fields of type t according to the rules of encoding/json.
+func isQuotable(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) ||
+		reflect.PointerTo(t).Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+// isEmptyValue reports whether v is empty according to the omitempty rules of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+// decodeCanonical decodes a JSON document into a tree suitable for writeCanonical.
+// Like I-JSON (RFC 7493), on which RFC 8785 builds, it rejects objects with duplicate keys.
+func decodeCanonical(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	tree, err := decodeCanonicalValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return tree, nil
+}
+
+// decodeCanonicalValue decodes the next JSON value from dec.
+func decodeCanonicalValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			elem, err := decodeCanonicalValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, elem)
+		}
+		_, err := dec.Token() // closing bracket
+		return arr, err
+	case json.Delim('{'):
+		obj := make(map[string]any)
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key := tok.(string)
+			if _, ok := obj[key]; ok {
+				return nil, fmt.Errorf("duplicate key %q in JSON object", key)
+			}
+			if obj[key], err = decodeCanonicalValue(dec); err != nil {
+				return nil, err
+			}
+		}
+		_, err := dec.Token() // closing brace
+		return obj, err
+	default:
+		return tok, nil
+	}
+}
+
+// writeCanonical writes the RFC 8785 representation of tree to buf.
+func writeCanonical(buf *bytes.Buffer, tree any) error {
+	switch v := tree.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return err
+		}
+		if !strings.ContainsAny(string(v), ".eE") {
+			if i, err := strconv.ParseInt(string(v), 10, 64); err != nil || i > maxSafeInteger || i < -maxSafeInteger {
+				return fmt.Errorf("integer %s cannot be represented exactly in canonical JSON", v)
+			}
+		}
+		return writeCanonicalNumber(buf, f, 64)
+	case float32:
+		return writeCanonicalNumber(buf, float64(v), 32)
+	case float64:
+		return writeCanonicalNumber(buf, v, 64)
+	case string:
+		return writeCanonicalString(buf, v)
+	case []any:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		// RFC 8785 requires keys to be sorted by their UTF-16 code units
+		slices.SortFunc(keys, func(a, b string) int {
+			return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+		})
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalString(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unsupported type %T for canonical JSON", v)
+	}
+	return nil
+}
+
+// writeCanonicalNumber writes f using the ECMAScript Number serialization required by RFC 8785.
+// Like encoding/json, a float32 is formatted with the shortest representation that round-trips at 32-bit precision.
+func writeCanonicalNumber(buf *bytes.Buffer, f float64, bitSize int) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("number %v cannot be represented in canonical JSON", f)
+	}
+	if f == 0 {
+		buf.WriteByte('0') // also covers negative zero
+		return nil
+	}
+	if f < 0 {
+		buf.WriteByte('-')
+		f = -f
+	}
+	format := byte('e')
+	if f >= 1e-6 && f < 1e21 {
+		format = 'f'
+	}
+	str := strconv.FormatFloat(f, format, -1, bitSize)
+	if exp := strings.IndexByte(str, 'e'); exp > 0 && str[exp+2] == '0' {
+		str = str[:exp+2] + str[exp+3:] // ECMAScript uses 1e+9 instead of 1e+09
+	}
+	buf.WriteString(str)
+	return nil
+}
+
+// writeCanonicalString writes s as a JSON string using the minimal escaping required by RFC 8785.
+func writeCanonicalString(buf *bytes.Buffer, s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("string %q is not valid UTF-8", s)
+	}
+	const hex = "0123456789abcdef"
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[c>>4])
+				buf.WriteByte(hex[c&0x0f])
+			} else {
+				buf.WriteByte(c)
+			}
+		}
+	}
+	buf.WriteByte('"')
+	return nil
+}
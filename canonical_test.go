@@ -0,0 +1,282 @@
+package uuid
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+type canonicalInner struct {
+	Shard int    `json:"shard"`
+	Name  string `json:"name"`
+}
+
+type canonicalEvent struct {
+	canonicalInner
+	ID         UUID              `json:"id"`
+	Type       string            `json:"type"`
+	Amount     float64           `json:"amount"`
+	Tags       []string          `json:"tags,omitempty"`
+	Meta       map[string]any    `json:"meta,omitempty"`
+	ReceivedAt time.Time         `json:"received_at" uuid:"-"`
+	Secret     string            `json:"-"`
+	Name       string            `json:"name"`
+	Counts     map[int]int       `json:"counts,omitempty"`
+	Raw        json.RawMessage   `json:"raw,omitempty"`
+	Labels     map[string]string `json:"labels"`
+	internal   int
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{
+			"RFC8785",
+			json.RawMessage(`{
+				"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+				"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+				"literals": [null, true, false]
+			}`),
+			`{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+			false,
+		},
+		{
+			"RFC8785Sorting",
+			json.RawMessage(`{"\u20ac":"Euro Sign","\r":"Carriage Return","\ufb33":"Hebrew Letter Dalet With Dagesh","1":"One","\ud83d\ude00":"Emoji: Grinning Face","\u0080":"Control","\u00f6":"Latin Small Letter O With Diaeresis"}`),
+			"{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\",\"\u20ac\":\"Euro Sign\",\"\U0001F600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+			false,
+		},
+		{
+			"Struct",
+			canonicalEvent{
+				canonicalInner: canonicalInner{Shard: 3, Name: "shadowed"},
+				ID:             UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+				Type:           "created",
+				Amount:         10.50,
+				ReceivedAt:     time.Now(),
+				Secret:         "hidden",
+				Name:           "event",
+				Counts:         map[int]int{2: 1, 10: 2},
+				Raw:            json.RawMessage(`{"b":1.0,"a":[]}`),
+				internal:       1,
+			},
+			`{"amount":10.5,"counts":{"10":2,"2":1},"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","labels":null,"name":"event","raw":{"a":[],"b":1},"shard":3,"type":"created"}`,
+			false,
+		},
+		{"Numbers", []float64{0, math.Copysign(0, -1), 1e20, 1e21, 0.000001, 1e-7, -1.5, 5e-324, math.MaxFloat64}, `[0,0,100000000000000000000,1e+21,0.000001,1e-7,-1.5,5e-324,1.7976931348623157e+308]`, false},
+		{"Bytes", []byte{0x01, 0x02, 0x03}, `"AQID"`, false},
+		{"JSONNumber", map[string]any{"n": json.Number("12"), "f": json.Number("1.50")}, `{"f":1.5,"n":12}`, false},
+		{"Float32", []float32{0.1, 1e21, 3.4028235e38}, `[0.1,1e+21,3.4028235e+38]`, false},
+		{
+			"StringOption",
+			struct {
+				I int     `json:",string"`
+				F float32 `json:"f,string"`
+				S string  `json:"s,omitempty,string"`
+				P *int    `json:"p,string"`
+				T []int   `json:"t,string"`
+			}{I: 5, F: 0.5, S: "x", T: []int{1}},
+			`{"I":"5","f":"0.5","p":null,"s":"\"x\"","t":[1]}`,
+			false,
+		},
+		{"TrailingData", json.RawMessage(`{"a":1} garbage`), ``, true},
+		{"TrailingValue", json.RawMessage(`{"a":1} {}`), ``, true},
+		{"DuplicateKey", json.RawMessage(`{"a":1,"a":2}`), ``, true},
+		{"DuplicateEscapedKey", json.RawMessage(`{"b":{"a":1,"\u0061":2}}`), ``, true},
+		{"Truncated", json.RawMessage(`{"a":[1,`), ``, true},
+		{"Nil", nil, `null`, false},
+		{"NaN", math.NaN(), ``, true},
+		{"UnsafeInteger", int64(1<<53 + 1), ``, true},
+		{"UnsafeJSONNumber", json.Number("9007199254740993"), ``, true},
+		{"UnsafeRawInteger", json.RawMessage(`{"a":-9007199254740993}`), ``, true},
+		{"LargeRawFloat", json.RawMessage(`[9007199254740993.0,1e300]`), `[9007199254740992,1e+300]`, false},
+		{"InvalidUTF8", "\xff", ``, true},
+		{"UnsupportedType", make(chan int), ``, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanonicalJSON(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CanonicalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("CanonicalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type canonicalTaggedA struct {
+	A int `json:"a"`
+}
+
+type canonicalTaggedB struct {
+	B int `json:"a"`
+}
+
+type canonicalTaggedUpper struct {
+	X int `json:"A"`
+}
+
+type canonicalUntagged struct {
+	A int
+}
+
+type canonicalDeep struct {
+	canonicalTaggedB
+}
+
+type canonicalNode struct {
+	Name string         `json:"name"`
+	Next *canonicalNode `json:"next"`
+}
+
+func TestCanonicalJSON_Embedded(t *testing.T) {
+	type conflict struct {
+		canonicalTaggedA
+		*canonicalTaggedB
+		B int `json:"b"`
+	}
+	type shallowest struct {
+		canonicalDeep
+		canonicalTaggedA
+	}
+	type taggedWins struct {
+		canonicalUntagged
+		canonicalTaggedUpper
+	}
+	type omitted struct {
+		*canonicalTaggedA
+		canonicalDeep
+	}
+	values := []any{
+		conflict{canonicalTaggedA{1}, &canonicalTaggedB{2}, 3},
+		shallowest{canonicalDeep{canonicalTaggedB{1}}, canonicalTaggedA{2}},
+		taggedWins{canonicalUntagged{1}, canonicalTaggedUpper{2}},
+		omitted{nil, canonicalDeep{canonicalTaggedB{1}}},
+	}
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := CanonicalJSON(json.RawMessage(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := CanonicalJSON(v)
+		if err != nil {
+			t.Errorf("CanonicalJSON(%#v) error = %v", v, err)
+		} else if string(got) != string(want) {
+			t.Errorf("CanonicalJSON(%#v) = %s, want %s", v, got, want)
+		}
+	}
+	if got, _ := CanonicalJSON(values[0]); string(got) != `{"b":3}` {
+		t.Errorf("CanonicalJSON() = %s, want the conflicting field to be dropped", got)
+	}
+	if got, _ := CanonicalJSON(values[1]); string(got) != `{"a":2}` {
+		t.Errorf("CanonicalJSON() = %s, want the shallower field", got)
+	}
+	if got, _ := CanonicalJSON(values[2]); string(got) != `{"A":2}` {
+		t.Errorf("CanonicalJSON() = %s, want the tagged field", got)
+	}
+}
+
+func TestCanonicalJSON_Cycle(t *testing.T) {
+	node := &canonicalNode{Name: "a"}
+	node.Next = &canonicalNode{Name: "b", Next: node}
+	if _, err := CanonicalJSON(node); err == nil {
+		t.Errorf("CanonicalJSON() of a pointer cycle did not return an error")
+	}
+	m := map[string]any{}
+	m["self"] = m
+	if _, err := CanonicalJSON(m); err == nil {
+		t.Errorf("CanonicalJSON() of a map containing itself did not return an error")
+	}
+	// Values referenced more than once without a cycle are encoded each time
+	shared := &canonicalNode{Name: "shared"}
+	got, err := CanonicalJSON([]*canonicalNode{shared, shared})
+	if want := `[{"name":"shared","next":null},{"name":"shared","next":null}]`; err != nil || string(got) != want {
+		t.Errorf("CanonicalJSON() = %s, %v, want %s", got, err, want)
+	}
+}
+
+func TestCanonicalJSON_EncodingJSON(t *testing.T) {
+	type quoted struct {
+		I int     `json:"i,string"`
+		B bool    `json:"b,string"`
+		F float32 `json:"f"`
+		N json.Number
+	}
+	values := []any{
+		quoted{I: 5, B: true, F: 0.1, N: "12"},
+		[]float32{0.1, 1.1, 16777216},
+		map[string]json.Number{"n": "12", "m": "-0.5"},
+	}
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := CanonicalJSON(json.RawMessage(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := CanonicalJSON(v)
+		if err != nil {
+			t.Errorf("CanonicalJSON(%#v) error = %v", v, err)
+		} else if string(got) != string(want) {
+			t.Errorf("CanonicalJSON(%#v) = %s, want %s as for %s", v, got, want, data)
+		}
+	}
+	// Decoding with UseNumber must not change the canonical encoding
+	dec := json.NewDecoder(strings.NewReader(`{"n":12,"f":0.1}`))
+	dec.UseNumber()
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := CanonicalJSON(tree); err != nil || string(got) != `{"f":0.1,"n":12}` {
+		t.Errorf("CanonicalJSON() of value decoded with UseNumber = %s, %v", got, err)
+	}
+}
+
+func TestNewV5JSON(t *testing.T) {
+	a, err := NewV5JSON(NamespaceURL(), json.RawMessage(`{"b": 2.0, "a": "x"}`))
+	if err != nil {
+		t.Fatalf("NewV5JSON() error = %v", err)
+	}
+	b, err := NewV5JSON(NamespaceURL(), map[string]any{"a": "x", "b": 2})
+	if err != nil {
+		t.Fatalf("NewV5JSON() error = %v", err)
+	}
+	if a != b {
+		t.Errorf("NewV5JSON() = %v and %v, want equal UUIDs", a, b)
+	}
+	if want := NewV5(NamespaceURL(), `{"a":"x","b":2}`); a != want {
+		t.Errorf("NewV5JSON() = %v, want %v", a, want)
+	}
+	if _, err := NewV5JSON(NamespaceURL(), math.Inf(1)); err == nil {
+		t.Errorf("NewV5JSON() expected error for infinite number")
+	}
+}
+
+func TestNewV8SHA256JSON(t *testing.T) {
+	id, err := NewV8SHA256JSON(NamespaceURL(), map[string]any{"b": 2, "a": "x"})
+	if err != nil {
+		t.Fatalf("NewV8SHA256JSON() error = %v", err)
+	}
+	if want := NewV8SHA256(NamespaceURL(), `{"a":"x","b":2}`); id != want {
+		t.Errorf("NewV8SHA256JSON() = %v, want %v", id, want)
+	}
+	if _, err := NewV8SHA256JSON(NamespaceURL(), math.NaN()); err == nil {
+		t.Errorf("NewV8SHA256JSON() expected error for NaN")
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/fossoreslp/uuid"
+)
+
+// extractOptions holds the flags of the extract command
+type extractOptions struct {
+	version int
+	since   string
+	until   string
+	count   bool
+	format  string
+}
+
+func runExtract(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var opts extractOptions
+	fs := flag.NewFlagSet("extract", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.IntVar(&opts.version, "version", 0, "only extract UUIDs of this version (0 extracts all versions)")
+	fs.StringVar(&opts.since, "since", "", "only extract time-based UUIDs with a timestamp at or after this RFC 3339 time")
+	fs.StringVar(&opts.until, "until", "", "only extract time-based UUIDs with a timestamp before this RFC 3339 time")
+	fs.BoolVar(&opts.count, "count", false, "print the number of occurrences of each UUID")
+	fs.StringVar(&opts.format, "format", "canonical", "output encoding (canonical, hex, urn, braces or base64)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: uuid extract [flags] [file ...]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Extracts UUIDs in canonical, braced, URN or hyphenless hex form from the files or standard input")
+		fmt.Fprintln(stderr, "and prints each distinct UUID once in sorted order.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	keep, err := opts.filter()
+	if err != nil {
+		fmt.Fprintf(stderr, "uuid extract: %v\n", err)
+		return 2
+	}
+	if _, err := encode(uuid.UUID{}, opts.format); err != nil {
+		fmt.Fprintf(stderr, "uuid extract: %v\n", err)
+		return 2
+	}
+
+	counts := make(map[uuid.UUID]int)
+	scan := func(r io.Reader) error {
+		s := uuid.NewScanner(r)
+		for s.Scan() {
+			if id := s.UUID(); keep(id) {
+				counts[id]++
+			}
+		}
+		return s.Err()
+	}
+	if fs.NArg() == 0 {
+		if err := scan(stdin); err != nil {
+			fmt.Fprintf(stderr, "uuid extract: %v\n", err)
+			return 1
+		}
+	}
+	for _, name := range fs.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "uuid extract: %v\n", err)
+			return 1
+		}
+		err = scan(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "uuid extract: %s: %v\n", name, err)
+			return 1
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	if opts.count {
+		// Most frequent first, ties in byte order
+		slices.SortFunc(ids, func(a, b uuid.UUID) int {
+			return cmp.Or(cmp.Compare(counts[b], counts[a]), bytes.Compare(a[:], b[:]))
+		})
+	} else {
+		slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	}
+	w := bufio.NewWriter(stdout)
+	for _, id := range ids {
+		s, _ := encode(id, opts.format)
+		if opts.count {
+			fmt.Fprintf(w, "%d\t%s\n", counts[id], s)
+		} else {
+			fmt.Fprintln(w, s)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(stderr, "uuid extract: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// filter validates the options and returns a function reporting whether a UUID should be extracted.
+// If a time range is set, UUIDs without a timestamp are excluded.
+func (opts extractOptions) filter() (func(uuid.UUID) bool, error) {
+	if opts.version < 0 || opts.version > 15 {
+		return nil, fmt.Errorf("invalid version %d", opts.version)
+	}
+	var since, until time.Time
+	var err error
+	if opts.since != "" {
+		if since, err = time.Parse(time.RFC3339Nano, opts.since); err != nil {
+			return nil, err
+		}
+	}
+	if opts.until != "" {
+		if until, err = time.Parse(time.RFC3339Nano, opts.until); err != nil {
+			return nil, err
+		}
+	}
+	return func(id uuid.UUID) bool {
+		if opts.version != 0 && id.Version() != opts.version {
+			return false
+		}
+		if since.IsZero() && until.IsZero() {
+			return true
+		}
+		if !id.HasTimestamp() {
+			return false
+		}
+		t := id.Timestamp()
+		return (since.IsZero() || !t.Before(since)) && (until.IsZero() || t.Before(until))
+	}, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const extractLog = `2022-02-22T19:22:22Z request={017F22E2-79B0-7CC3-98C4-DC0C0C07398F} user=2ed6657d-e927-568b-95e1-2665a8aea6a2
+2022-02-22T19:22:23Z request=urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f session=c232ab00941411ecb3c89f6bdeced846
+2022-02-22T19:22:24Z request=018f22e2-79b0-7cc3-98c4-dc0c0c07398f user=2ed6657d-e927-568b-95e1-2665a8aea6a2 md5=d41d8cd98f00b204e9800998ecf8427e
+`
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		want     string
+	}{
+		{"Unique", nil, 0, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n018f22e2-79b0-7cc3-98c4-dc0c0c07398f\n2ed6657d-e927-568b-95e1-2665a8aea6a2\nc232ab00-9414-11ec-b3c8-9f6bdeced846\n"},
+		{"Count", []string{"-count"}, 0, "2\t017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n2\t2ed6657d-e927-568b-95e1-2665a8aea6a2\n1\t018f22e2-79b0-7cc3-98c4-dc0c0c07398f\n1\tc232ab00-9414-11ec-b3c8-9f6bdeced846\n"},
+		{"Version", []string{"-version", "5"}, 0, "2ed6657d-e927-568b-95e1-2665a8aea6a2\n"},
+		{"Since", []string{"-since", "2022-02-22T19:22:22Z"}, 0, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n018f22e2-79b0-7cc3-98c4-dc0c0c07398f\nc232ab00-9414-11ec-b3c8-9f6bdeced846\n"},
+		{"SinceUntil", []string{"-since", "2022-02-22T19:22:22Z", "-until", "2023-01-01T00:00:00Z", "-version", "7"}, 0, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n"},
+		{"Until", []string{"-until", "2022-02-22T19:22:22Z"}, 0, ""},
+		{"Format", []string{"-version", "1", "-format", "urn"}, 0, "urn:uuid:c232ab00-9414-11ec-b3c8-9f6bdeced846\n"},
+		{"InvalidSince", []string{"-since", "yesterday"}, 2, ""},
+		{"InvalidVersion", []string{"-version", "16"}, 2, ""},
+		{"InvalidFormat", []string{"-format", "base2"}, 2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(append([]string{"extract"}, tt.args...), strings.NewReader(extractLog), &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("output = %q, want %q", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractFiles(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")
+	os.WriteFile(first, []byte("id=2ed6657d-e927-568b-95e1-2665a8aea6a2\n"), 0o600)
+	os.WriteFile(second, []byte("id=2ed6657d-e927-568b-95e1-2665a8aea6a2\n"), 0o600)
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"extract", "-count", first, second}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit code = %d, want 0 (stderr: %s)", code, stderr.String())
+	}
+	if want := "2\t2ed6657d-e927-568b-95e1-2665a8aea6a2\n"; stdout.String() != want {
+		t.Errorf("output = %q, want %q", stdout.String(), want)
+	}
+	if code := run([]string{"extract", filepath.Join(dir, "missing.log")}, nil, &stdout, &stderr); code != 1 {
+		t.Errorf("exit code = %d, want 1 for missing file", code)
+	}
+}
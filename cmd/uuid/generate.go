@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/fossoreslp/uuid"
+)
+
+// Timestamp ranges of the time-based versions, with exclusive upper limits
+var (
+	gregorianFirst = time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)
+	gregorianLimit = time.Unix(1<<60/10000000-12219292800, 1<<60%10000000*100) // 2^60 100-nanosecond intervals after gregorianFirst
+	unixFirst      = time.Unix(0, 0)
+	unixLimit      = time.UnixMilli(1 << 48)
+)
+
+// generateOptions holds the flags of the generate command
+type generateOptions struct {
+	count     int
+	version   int
+	namespace uuid.Namespace
+	nsSet     bool
+	name      string
+	time      string
+	node      string
+	format    string
+}
+
+func runGenerate(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	var opts generateOptions
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.IntVar(&opts.count, "n", 1, "number of UUIDs to generate")
+	fs.IntVar(&opts.version, "v", 4, "UUID version (1, 3, 4, 5, 6, 7 or 8)")
+	fs.TextVar(&opts.namespace, "ns", uuid.Namespace{}, "namespace for name-based UUIDs as UUID or registered name (dns, url, oid, x500), required with -name")
+	fs.StringVar(&opts.name, "name", "", "name for name-based UUIDs (v3, v5 and v8 using SHA-256)")
+	fs.StringVar(&opts.time, "time", "", "timestamp for time-based UUIDs (v1, v6 and v7) in RFC 3339 format")
+	fs.StringVar(&opts.node, "node", "", "node for v1 and v6 UUIDs as MAC address")
+	fs.StringVar(&opts.format, "format", "canonical", "output encoding (canonical, hex, urn, braces or base64)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: uuid generate [flags]")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "uuid generate: unexpected argument %q\n", fs.Arg(0))
+		return 2
+	}
+	fs.Visit(func(f *flag.Flag) { opts.nsSet = opts.nsSet || f.Name == "ns" })
+	gen, err := opts.generator()
+	if err != nil {
+		fmt.Fprintf(stderr, "uuid generate: %v\n", err)
+		return 2
+	}
+	if _, err := encode(uuid.UUID{}, opts.format); err != nil {
+		fmt.Fprintf(stderr, "uuid generate: %v\n", err)
+		return 2
+	}
+	w := bufio.NewWriter(stdout)
+	for range opts.count {
+		id, err := gen()
+		if err != nil {
+			fmt.Fprintf(stderr, "uuid generate: %v\n", err)
+			return 1
+		}
+		s, _ := encode(id, opts.format)
+		fmt.Fprintln(w, s)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(stderr, "uuid generate: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// generator validates the options and returns a function generating a single UUID
+func (opts generateOptions) generator() (func() (uuid.UUID, error), error) {
+	if opts.count < 0 {
+		return nil, errors.New("count must not be negative")
+	}
+	nameBased := opts.version == 3 || opts.version == 5 || opts.version == 8
+	timeBased := opts.version == 1 || opts.version == 6 || opts.version == 7
+	if opts.name != "" && !nameBased {
+		return nil, fmt.Errorf("-name is not supported for version %d", opts.version)
+	}
+	if opts.name != "" && !opts.nsSet {
+		return nil, fmt.Errorf("version %d requires -ns", opts.version)
+	}
+	if opts.nsSet && !nameBased {
+		return nil, fmt.Errorf("-ns is not supported for version %d", opts.version)
+	}
+	if opts.nsSet && opts.name == "" {
+		return nil, errors.New("-ns requires -name")
+	}
+	if opts.time != "" && !timeBased {
+		return nil, fmt.Errorf("-time is not supported for version %d", opts.version)
+	}
+	if opts.node != "" && opts.version != 1 && opts.version != 6 {
+		return nil, fmt.Errorf("-node is not supported for version %d", opts.version)
+	}
+	var node net.HardwareAddr
+	if opts.node != "" {
+		var err error
+		if node, err = net.ParseMAC(opts.node); err != nil {
+			return nil, err
+		}
+		if len(node) != 6 {
+			return nil, fmt.Errorf("node must be a 48-bit MAC address")
+		}
+	}
+	var ts time.Time
+	if opts.time != "" {
+		var err error
+		if ts, err = time.Parse(time.RFC3339Nano, opts.time); err != nil {
+			return nil, err
+		}
+		// The range is checked here, as generating a UUID to check it would advance the clock sequence of v1 and v6
+		first, limit := gregorianFirst, gregorianLimit
+		if opts.version == 7 {
+			first, limit = unixFirst, unixLimit
+		}
+		if ts.Before(first) || !ts.Before(limit) {
+			return nil, fmt.Errorf("time must be between %s and %s for version %d", first.Format(time.RFC3339), limit.Format(time.RFC3339), opts.version)
+		}
+	}
+	ns := opts.namespace.UUID()
+	switch opts.version {
+	case 1:
+		if opts.time == "" && node == nil {
+			return wrap(uuid.NewV1), nil
+		}
+		return func() (uuid.UUID, error) { return uuid.NewV1At(timeOrNow(ts), node) }, nil
+	case 3:
+		if opts.name == "" {
+			return nil, errors.New("version 3 requires -name")
+		}
+		return wrap(func() uuid.UUID { return uuid.NewV3(ns, opts.name) }), nil
+	case 4:
+		return wrap(uuid.NewV4), nil
+	case 5:
+		if opts.name == "" {
+			return nil, errors.New("version 5 requires -name")
+		}
+		return wrap(func() uuid.UUID { return uuid.NewV5(ns, opts.name) }), nil
+	case 6:
+		if opts.time == "" && node == nil {
+			return wrap(uuid.NewV6), nil
+		}
+		return func() (uuid.UUID, error) { return uuid.NewV6At(timeOrNow(ts), node) }, nil
+	case 7:
+		if opts.time == "" {
+			return wrap(uuid.NewV7), nil
+		}
+		return func() (uuid.UUID, error) { return uuid.NewV7At(ts) }, nil
+	case 8:
+		if opts.name != "" {
+			return wrap(func() uuid.UUID { return uuid.NewV8SHA256(ns, opts.name) }), nil
+		}
+		return func() (uuid.UUID, error) {
+			var data [16]byte
+			if _, err := rand.Read(data[:]); err != nil {
+				return uuid.UUID{}, err
+			}
+			return uuid.NewV8(data[:]), nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported version %d", opts.version)
+	}
+}
+
+func wrap(f func() uuid.UUID) func() (uuid.UUID, error) {
+	return func() (uuid.UUID, error) { return f(), nil }
+}
+
+// timeOrNow returns t or the current time if t is the zero value
+func timeOrNow(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now()
+	}
+	return t
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		want     *regexp.Regexp
+		wantN    int
+	}{
+		{"Default", nil, 0, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), 1},
+		{"Count", []string{"-n", "3", "-v", "7"}, 0, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7`), 3},
+		{"V3", []string{"-v", "3", "-ns", "dns", "-name", "www.example.com"}, 0, regexp.MustCompile(`^5df41881-3aed-3515-88a7-2f4a814cf09e$`), 1},
+		{"V5", []string{"-v", "5", "-ns", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "-name", "www.example.com"}, 0, regexp.MustCompile(`^2ed6657d-e927-568b-95e1-2665a8aea6a2$`), 1},
+		{"V8SHA256", []string{"-v", "8", "-ns", "dns", "-name", "www.example.com"}, 0, regexp.MustCompile(`^5c146b14-3c52-8afd-938a-375d0df1fbf6$`), 1},
+		{"V8Random", []string{"-v", "8"}, 0, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-8[0-9a-f]{3}-[89ab]`), 1},
+		{"V1TimeNode", []string{"-v", "1", "-time", "2022-02-22T19:22:22Z", "-node", "9f:6b:de:ce:d8:46"}, 0, regexp.MustCompile(`^c232ab00-9414-11ec-[89ab][0-9a-f]{3}-9f6bdeced846$`), 1},
+		{"V6TimeNode", []string{"-v", "6", "-time", "2022-02-22T19:22:22Z", "-node", "9f:6b:de:ce:d8:46"}, 0, regexp.MustCompile(`^1ec9414c-232a-6b00-[89ab][0-9a-f]{3}-9f6bdeced846$`), 1},
+		{"V7Time", []string{"-v", "7", "-time", "2022-02-22T19:22:22Z"}, 0, regexp.MustCompile(`^017f22e2-79b0-7000-`), 1},
+		{"Hex", []string{"-v", "5", "-ns", "dns", "-name", "www.example.com", "-format", "hex"}, 0, regexp.MustCompile(`^2ed6657de927568b95e12665a8aea6a2$`), 1},
+		{"URN", []string{"-v", "5", "-ns", "dns", "-name", "www.example.com", "-format", "urn"}, 0, regexp.MustCompile(`^urn:uuid:2ed6657d-e927-568b-95e1-2665a8aea6a2$`), 1},
+		{"Braces", []string{"-v", "5", "-ns", "dns", "-name", "www.example.com", "-format", "braces"}, 0, regexp.MustCompile(`^\{2ed6657d-e927-568b-95e1-2665a8aea6a2\}$`), 1},
+		{"Base64", []string{"-v", "5", "-ns", "dns", "-name", "www.example.com", "-format", "base64"}, 0, regexp.MustCompile(`^LtZlfeknVouV4SZlqK6mog$`), 1},
+		{"MissingName", []string{"-v", "5", "-ns", "dns"}, 2, nil, 0},
+		{"MissingNamespaceV3", []string{"-v", "3", "-name", "example.com"}, 2, nil, 0},
+		{"MissingNamespaceV5", []string{"-v", "5", "-name", "example.com"}, 2, nil, 0},
+		{"MissingNamespaceV8", []string{"-v", "8", "-name", "example.com"}, 2, nil, 0},
+		{"NamespaceWithoutNameV8", []string{"-v", "8", "-ns", "dns"}, 2, nil, 0},
+		{"V7Time1970", []string{"-v", "7", "-time", "1970-01-01T00:00:00Z"}, 0, regexp.MustCompile(`^00000000-0000-7`), 1},
+		{"V7TimeBeforeUnixEpoch", []string{"-v", "7", "-time", "1969-12-31T23:59:59.999Z"}, 2, nil, 0},
+		{"V6TimeMax", []string{"-v", "6", "-time", "5236-03-31T21:21:00.684697599Z", "-node", "9f:6b:de:ce:d8:46"}, 0, regexp.MustCompile(`^ffffffff-ffff-6fff-`), 1},
+		{"V6TimeAfterMax", []string{"-v", "6", "-time", "5236-03-31T21:21:00.6846976Z"}, 2, nil, 0},
+		{"NamespaceForRandom", []string{"-v", "4", "-ns", "dns"}, 2, nil, 0},
+		{"NameForRandom", []string{"-v", "4", "-name", "x"}, 2, nil, 0},
+		{"TimeForNameBased", []string{"-v", "5", "-ns", "dns", "-name", "x", "-time", "2022-02-22T19:22:22Z"}, 2, nil, 0},
+		{"NodeForV7", []string{"-v", "7", "-node", "9f:6b:de:ce:d8:46"}, 2, nil, 0},
+		{"InvalidTime", []string{"-v", "7", "-time", "yesterday"}, 2, nil, 0},
+		{"V7TimeBefore1970", []string{"-v", "7", "-time", "1960-01-01T00:00:00Z"}, 2, nil, 0},
+		{"V1TimeBefore1582", []string{"-v", "1", "-time", "1582-10-14T00:00:00Z"}, 2, nil, 0},
+		{"V6TimeAfter5236", []string{"-v", "6", "-time", "5237-01-01T00:00:00Z"}, 2, nil, 0},
+		{"V1Time1582", []string{"-v", "1", "-time", "1582-10-15T00:00:00Z", "-node", "9f:6b:de:ce:d8:46"}, 0, regexp.MustCompile(`^00000000-0000-1000-[89ab][0-9a-f]{3}-9f6bdeced846$`), 1},
+		{"InvalidNode", []string{"-v", "1", "-node", "9f:6b"}, 2, nil, 0},
+		{"InvalidVersion", []string{"-v", "2"}, 2, nil, 0},
+		{"InvalidFormat", []string{"-format", "base2"}, 2, nil, 0},
+		{"UnknownNamespace", []string{"-v", "5", "-ns", "not a namespace", "-name", "x"}, 2, nil, 0},
+		{"UnexpectedArgument", []string{"extra"}, 2, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(append([]string{"generate"}, tt.args...), nil, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr: %s)", code, tt.wantCode, stderr.String())
+			}
+			if tt.want == nil {
+				return
+			}
+			lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+			if len(lines) != tt.wantN {
+				t.Fatalf("got %d lines, want %d", len(lines), tt.wantN)
+			}
+			for _, line := range lines {
+				if !tt.want.MatchString(line) {
+					t.Errorf("output %q does not match %v", line, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunUnknownCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"frobnicate"}, nil, &stdout, &stderr); code != 2 {
+		t.Errorf("exit code = %d, want 2", code)
+	}
+	if !strings.Contains(stderr.String(), "generate") {
+		t.Errorf("usage does not list commands: %s", stderr.String())
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/fossoreslp/uuid"
+)
+
+// inspection describes the fields of a UUID as printed by the inspect command
+type inspection struct {
+	Input         string     `json:"input"`
+	UUID          string     `json:"uuid"`
+	Format        string     `json:"format"`
+	Version       int        `json:"version"`
+	Variant       string     `json:"variant"`
+	Time          *time.Time `json:"time,omitempty"`
+	LocalTime     *time.Time `json:"local_time,omitempty"`
+	ClockSequence *int       `json:"clock_sequence,omitempty"`
+	Node          string     `json:"node,omitempty"`
+	NodeType      string     `json:"node_type,omitempty"`
+	RandA         string     `json:"rand_a,omitempty"`
+	RandB         string     `json:"rand_b,omitempty"`
+	Error         string     `json:"error,omitempty"`
+}
+
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "print results as JSON, one object per line")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: uuid inspect [flags] [id ...]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "IDs are read from standard input, one per line, if none are given as arguments.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		s := bufio.NewScanner(stdin)
+		for s.Scan() {
+			if line := strings.TrimSpace(s.Text()); line != "" {
+				inputs = append(inputs, line)
+			}
+		}
+		if err := s.Err(); err != nil {
+			fmt.Fprintf(stderr, "uuid inspect: %v\n", err)
+			return 1
+		}
+	}
+	code := 0
+	enc := json.NewEncoder(stdout)
+	for i, in := range inputs {
+		info := inspect(in)
+		if info.Error != "" {
+			code = 1
+		}
+		if *asJSON {
+			enc.Encode(info)
+			continue
+		}
+		if info.Error != "" {
+			fmt.Fprintf(stderr, "uuid inspect: %s: %s\n", in, info.Error)
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		info.print(stdout)
+	}
+	return code
+}
+
+// parseInput parses any representation accepted by uuid.ParseAnyText as well as URNs, hex strings and canonical UUIDs in braces
+func parseInput(in string) (uuid.UUID, string, error) {
+	if len(in) == 38 && in[0] == '{' && in[37] == '}' {
+		id, err := uuid.Parse(in[1:37])
+		return id, "braces", err
+	}
+	// The URN and hex codecs are not registered for ParseAny by default
+	for _, c := range []uuid.Codec{uuid.URNCodec, uuid.HexCodec} {
+		if c.Detect([]byte(in)) {
+			id, err := c.Decode([]byte(in))
+			return id, c.Name(), err
+		}
+	}
+	id, codec, err := uuid.ParseAnyText([]byte(in))
+	if err != nil {
+		return uuid.UUID{}, "", err
+	}
+	return id, codec.Name(), nil
+}
+
+// inspect parses the input and extracts the fields defined for its version
+func inspect(in string) inspection {
+	info := inspection{Input: in}
+	id, format, err := parseInput(strings.TrimSpace(in))
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.UUID = id.String()
+	info.Format = format
+	info.Version = id.Version()
+	info.Variant = id.Variant().String()
+	if id.Variant() != uuid.VariantRFC9562 {
+		return info
+	}
+	switch info.Version {
+	case 1, 6:
+		t := id.Timestamp().UTC()
+		local := t.Local()
+		seq := int(binary.BigEndian.Uint16(id[8:10]) & 0x3FFF)
+		info.Time, info.LocalTime, info.ClockSequence = &t, &local, &seq
+		info.Node = net.HardwareAddr(id[10:]).String()
+		// Randomly generated node IDs have the multicast bit set as required by RFC 9562 Section 6.10
+		if id[10]&0x01 != 0 {
+			info.NodeType = "random"
+		} else {
+			info.NodeType = "mac"
+		}
+	case 7:
+		t := id.Timestamp().UTC()
+		local := t.Local()
+		info.Time, info.LocalTime = &t, &local
+		info.RandA = fmt.Sprintf("%03x", binary.BigEndian.Uint16(id[6:8])&0x0FFF)
+		info.RandB = fmt.Sprintf("%016x", binary.BigEndian.Uint64(id[8:16])&0x3FFFFFFFFFFFFFFF)
+	}
+	return info
+}
+
+// print writes the inspection as aligned key-value pairs
+func (info inspection) print(w io.Writer) {
+	field := func(key, value string) { fmt.Fprintf(w, "%-15s %s\n", key+":", value) }
+	field("uuid", info.UUID)
+	field("format", info.Format)
+	field("version", fmt.Sprint(info.Version))
+	field("variant", info.Variant)
+	if info.Time != nil {
+		field("time", info.Time.Format(time.RFC3339Nano))
+		field("local time", info.LocalTime.Format(time.RFC3339Nano))
+	}
+	if info.ClockSequence != nil {
+		field("clock sequence", fmt.Sprint(*info.ClockSequence))
+	}
+	if info.Node != "" {
+		field("node", info.Node+" ("+info.NodeType+")")
+	}
+	if info.RandA != "" {
+		field("rand_a", "0x"+info.RandA)
+		field("rand_b", "0x"+info.RandB)
+	}
+}
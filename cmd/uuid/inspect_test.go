@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInspect(t *testing.T) {
+	rfcTime := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	tests := []struct {
+		name      string
+		in        string
+		format    string
+		version   int
+		variant   string
+		time      time.Time
+		clockSeq  int
+		node      string
+		nodeType  string
+		randA     string
+		randB     string
+		wantError bool
+	}{
+		{"V1", "C232AB00-9414-11EC-B3C8-9F6BDECED846", "canonical", 1, "RFC 9562", rfcTime, 0x33C8, "9f:6b:de:ce:d8:46", "random", "", "", false},
+		{"V6URN", "urn:uuid:1ec9414c-232a-6b00-b3c8-9e6bdeced846", "urn", 6, "RFC 9562", rfcTime, 0x33C8, "9e:6b:de:ce:d8:46", "mac", "", "", false},
+		{"V7Braces", "{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}", "braces", 7, "RFC 9562", rfcTime, -1, "", "", "cc3", "18c4dc0c0c07398f", false},
+		{"V5Hex", "2ed6657de927568b95e12665a8aea6a2", "hex", 5, "RFC 9562", time.Time{}, -1, "", "", "", "", false},
+		{"MaxBase32", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "base32", 15, "Future", time.Time{}, -1, "", "", "", "", false},
+		{"Invalid", "not-a-uuid", "", 0, "", time.Time{}, -1, "", "", "", "", true},
+		{"SixteenCharacters", "abcdefghijklmnop", "", 0, "", time.Time{}, -1, "", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := inspect(tt.in)
+			if (info.Error != "") != tt.wantError {
+				t.Fatalf("inspect() error = %q, wantError %v", info.Error, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if info.Format != tt.format || info.Version != tt.version || info.Variant != tt.variant {
+				t.Errorf("inspect() = %s/%d/%s, want %s/%d/%s", info.Format, info.Version, info.Variant, tt.format, tt.version, tt.variant)
+			}
+			if tt.time.IsZero() != (info.Time == nil) || (info.Time != nil && !info.Time.Equal(tt.time)) {
+				t.Errorf("inspect() time = %v, want %v", info.Time, tt.time)
+			}
+			if (tt.clockSeq < 0) != (info.ClockSequence == nil) || (info.ClockSequence != nil && *info.ClockSequence != tt.clockSeq) {
+				t.Errorf("inspect() clock sequence = %v, want %d", info.ClockSequence, tt.clockSeq)
+			}
+			if info.Node != tt.node || info.NodeType != tt.nodeType {
+				t.Errorf("inspect() node = %s (%s), want %s (%s)", info.Node, info.NodeType, tt.node, tt.nodeType)
+			}
+			if info.RandA != tt.randA || info.RandB != tt.randB {
+				t.Errorf("inspect() random = %s/%s, want %s/%s", info.RandA, info.RandB, tt.randA, tt.randB)
+			}
+		})
+	}
+}
+
+func TestRunInspect(t *testing.T) {
+	t.Run("Text", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{"inspect", "c232ab00-9414-11ec-b3c8-9f6bdeced846"}, nil, &stdout, &stderr); code != 0 {
+			t.Fatalf("exit code = %d, want 0 (stderr: %s)", code, stderr.String())
+		}
+		for _, want := range []string{"version:        1", "time:           2022-02-22T19:22:22Z", "clock sequence: 13256", "node:           9f:6b:de:ce:d8:46 (random)"} {
+			if !strings.Contains(stdout.String(), want) {
+				t.Errorf("output does not contain %q:\n%s", want, stdout.String())
+			}
+		}
+	})
+	t.Run("SixteenCharacters", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{"inspect", "abcdefghijklmnop"}, nil, &stdout, &stderr); code != 1 {
+			t.Errorf("exit code = %d, want 1 (stdout: %s)", code, stdout.String())
+		}
+	})
+	t.Run("StdinJSON", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		stdin := strings.NewReader("017f22e2-79b0-7cc3-98c4-dc0c0c07398f\n\ninvalid\n")
+		if code := run([]string{"inspect", "-json"}, stdin, &stdout, &stderr); code != 1 {
+			t.Fatalf("exit code = %d, want 1", code)
+		}
+		dec := json.NewDecoder(&stdout)
+		var first, second inspection
+		if err := dec.Decode(&first); err != nil {
+			t.Fatal(err)
+		}
+		if err := dec.Decode(&second); err != nil {
+			t.Fatal(err)
+		}
+		if first.Version != 7 || first.RandA != "cc3" || first.Time == nil {
+			t.Errorf("unexpected first result %+v", first)
+		}
+		if second.Input != "invalid" || second.Error == "" {
+			t.Errorf("unexpected second result %+v", second)
+		}
+	})
+}
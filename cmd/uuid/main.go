@@ -0,0 +1,87 @@
+// Command uuid generates, inspects and extracts UUIDs of every version supported by github.com/fossoreslp/uuid.
+//
+// Usage:
+//
+//	uuid <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	generate    generate one or more UUIDs
+//	inspect     print the fields of UUIDs
+//	extract     extract UUIDs from text such as log files
+//
+// Run "uuid <command> -h" for the flags accepted by a command.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fossoreslp/uuid"
+)
+
+// command is a subcommand of the uuid tool.
+// run receives the arguments following the command name and returns the exit code.
+type command struct {
+	name  string
+	short string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{"generate", "generate one or more UUIDs", runGenerate},
+	{"inspect", "print the fields of UUIDs", runInspect},
+	{"extract", "extract UUIDs from text such as log files", runExtract},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run dispatches to the command named by the first argument and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+	fmt.Fprintf(stderr, "uuid: unknown command %q\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: uuid <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s  %s\n", c.name, c.short)
+	}
+}
+
+// formats lists the output encodings accepted by encode.
+var formats = []string{"canonical", "hex", "urn", "braces", "base64"}
+
+// encode returns the UUID in the named output encoding.
+func encode(id uuid.UUID, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "canonical", "":
+		return id.String(), nil
+	case "hex":
+		return id.Hex(), nil
+	case "urn":
+		return id.URN(), nil
+	case "braces":
+		return "{" + id.String() + "}", nil
+	case "base64":
+		return id.Base64(), nil
+	default:
+		return "", fmt.Errorf("unknown format %q, must be one of %s", format, strings.Join(formats, ", "))
+	}
+}
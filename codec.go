@@ -0,0 +1,206 @@
+package uuid
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Codec converts UUIDs to and from a specific representation.
+// Codecs can be registered using RegisterCodec to be considered by ParseAny.
+type Codec interface {
+	// Name returns a unique name identifying the codec
+	Name() string
+	// Encode returns the representation of the UUID
+	Encode(uuid UUID) []byte
+	// Decode parses the representation returning either the resulting UUID or an error
+	Decode(in []byte) (UUID, error)
+	// Detect reports whether the input looks like it is in the codec's representation.
+	// It should be cheap and is only used to select a codec, so Decode may still fail.
+	Detect(in []byte) bool
+}
+
+// funcCodec implements Codec using functions and is used for all built-in codecs.
+type funcCodec struct {
+	name   string
+	encode func(UUID) []byte
+	decode func([]byte) (UUID, error)
+	detect func([]byte) bool
+}
+
+func (c funcCodec) Name() string                   { return c.name }
+func (c funcCodec) Encode(uuid UUID) []byte        { return c.encode(uuid) }
+func (c funcCodec) Decode(in []byte) (UUID, error) { return c.decode(in) }
+func (c funcCodec) Detect(in []byte) bool          { return c.detect(in) }
+
+// Built-in codecs. CanonicalCodec, BinaryCodec, OIDCodec and Base32Codec are registered by default.
+// Base64Codec, Base58Codec and Base62Codec all use 22 characters and cannot be told apart reliably,
+// so at most one of them should be registered by applications that use it.
+var (
+	CanonicalCodec Codec = funcCodec{
+		name:   "canonical",
+		encode: func(uuid UUID) []byte { return []byte(uuid.String()) },
+		decode: func(in []byte) (UUID, error) { return Parse(string(in)) },
+		detect: func(in []byte) bool {
+			return len(in) == 36 && in[8] == '-' && in[13] == '-' && in[18] == '-' && in[23] == '-'
+		},
+	}
+	BinaryCodec Codec = funcCodec{
+		name:   "binary",
+		encode: func(uuid UUID) []byte { return uuid[:] },
+		decode: func(in []byte) (uuid UUID, err error) {
+			err = uuid.UnmarshalBinary(in)
+			return
+		},
+		detect: func(in []byte) bool { return len(in) == 16 },
+	}
+	URNCodec Codec = funcCodec{
+		name:   "urn",
+		encode: func(uuid UUID) []byte { return []byte(uuid.URN()) },
+		decode: func(in []byte) (UUID, error) { return ParseURN(string(in)) },
+		detect: func(in []byte) bool { return len(in) == len(urnPrefix)+36 },
+	}
+	HexCodec Codec = funcCodec{
+		name:   "hex",
+		encode: func(uuid UUID) []byte { return []byte(uuid.Hex()) },
+		decode: func(in []byte) (UUID, error) { return ParseHex(string(in)) },
+		detect: func(in []byte) bool { return len(in) == 32 },
+	}
+	OIDCodec Codec = funcCodec{
+		name:   "oid",
+		encode: func(uuid UUID) []byte { return []byte(uuid.OID()) },
+		decode: func(in []byte) (UUID, error) { return ParseOID(string(in)) },
+		detect: func(in []byte) bool {
+			return strings.HasPrefix(string(in), oidPrefix) || (len(in) > 8 && strings.EqualFold(string(in[:8]), "urn:oid:"))
+		},
+	}
+	Base32Codec Codec = funcCodec{
+		name:   "base32",
+		encode: func(uuid UUID) []byte { return uuid.AppendBase32(nil) },
+		decode: func(in []byte) (UUID, error) { return ParseBase32(string(in)) },
+		detect: func(in []byte) bool { return len(in) == base32Length },
+	}
+	Base64Codec Codec = funcCodec{
+		name:   "base64",
+		encode: func(uuid UUID) []byte { return uuid.AppendBase64(nil) },
+		decode: func(in []byte) (UUID, error) { return ParseBase64(string(in)) },
+		detect: func(in []byte) bool { return len(in) == base64Length },
+	}
+	Base58Codec Codec = funcCodec{
+		name:   "base58",
+		encode: func(uuid UUID) []byte { return uuid.AppendBase58(nil) },
+		decode: func(in []byte) (UUID, error) { return ParseBase58(string(in)) },
+		detect: func(in []byte) bool { return len(in) == base58Length },
+	}
+	Base62Codec Codec = funcCodec{
+		name:   "base62",
+		encode: func(uuid UUID) []byte { return uuid.AppendBase62(nil) },
+		decode: func(in []byte) (UUID, error) { return ParseBase62(string(in)) },
+		detect: func(in []byte) bool { return len(in) == base62Length },
+	}
+)
+
+type registeredCodec struct {
+	codec    Codec
+	priority int
+}
+
+// codecs contains the registered codecs ordered by descending priority.
+var codecs struct {
+	sync.RWMutex
+	list []registeredCodec
+}
+
+func init() {
+	RegisterCodec(CanonicalCodec, 100)
+	RegisterCodec(BinaryCodec, 90)
+	RegisterCodec(OIDCodec, 80)
+	RegisterCodec(Base32Codec, 70)
+}
+
+// RegisterCodec registers a codec to be considered by ParseAny.
+// Codecs with a higher priority are tried first, codecs with equal priority are tried in the order they were registered.
+// An error is returned if a codec with the same name is already registered.
+func RegisterCodec(c Codec, priority int) error {
+	codecs.Lock()
+	defer codecs.Unlock()
+	for _, rc := range codecs.list {
+		if rc.codec.Name() == c.Name() {
+			return fmt.Errorf("codec %q is already registered", c.Name())
+		}
+	}
+	i := slices.IndexFunc(codecs.list, func(rc registeredCodec) bool { return rc.priority < priority })
+	if i < 0 {
+		i = len(codecs.list)
+	}
+	codecs.list = slices.Insert(codecs.list, i, registeredCodec{c, priority})
+	return nil
+}
+
+// UnregisterCodec removes the codec with the provided name and reports whether it was registered.
+func UnregisterCodec(name string) bool {
+	codecs.Lock()
+	defer codecs.Unlock()
+	i := slices.IndexFunc(codecs.list, func(rc registeredCodec) bool { return rc.codec.Name() == name })
+	if i < 0 {
+		return false
+	}
+	codecs.list = slices.Delete(codecs.list, i, i+1)
+	return true
+}
+
+// LookupCodec returns the registered codec with the provided name.
+func LookupCodec(name string) (Codec, bool) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	for _, rc := range codecs.list {
+		if rc.codec.Name() == name {
+			return rc.codec, true
+		}
+	}
+	return nil, false
+}
+
+// Codecs returns the registered codecs in the order they are tried by ParseAny.
+func Codecs() []Codec {
+	codecs.RLock()
+	defer codecs.RUnlock()
+	list := make([]Codec, len(codecs.list))
+	for i, rc := range codecs.list {
+		list[i] = rc.codec
+	}
+	return list
+}
+
+// ParseAny parses the input using the first registered codec that detects and successfully decodes it.
+// It returns the resulting UUID and the codec that matched or an error if no codec was able to decode the input.
+func ParseAny(in []byte) (UUID, Codec, error) {
+	return parseAny(in, false)
+}
+
+// ParseAnyText is like ParseAny but skips BinaryCodec, which would accept any text of 16 characters.
+// It should be used for input that is known to be text, such as command-line arguments or form values.
+func ParseAnyText(in []byte) (UUID, Codec, error) {
+	return parseAny(in, true)
+}
+
+func parseAny(in []byte, text bool) (UUID, Codec, error) {
+	var firstErr error
+	for _, c := range Codecs() {
+		if (text && c.Name() == BinaryCodec.Name()) || !c.Detect(in) {
+			continue
+		}
+		uuid, err := c.Decode(in)
+		if err == nil {
+			return uuid, c, nil
+		}
+		if firstErr == nil {
+			firstErr = fmt.Errorf("codec %s: %w", c.Name(), err)
+		}
+	}
+	if firstErr != nil {
+		return UUID{}, nil, firstErr
+	}
+	return UUID{}, nil, fmt.Errorf("no codec matched input of length %d", len(in))
+}
@@ -0,0 +1,119 @@
+package uuid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// upperHexCodec is a custom codec used to test registration
+type upperHexCodec struct{}
+
+func (upperHexCodec) Name() string { return "upperhex" }
+func (upperHexCodec) Encode(uuid UUID) []byte {
+	return []byte(strings.ToUpper(strings.ReplaceAll(uuid.String(), "-", "")))
+}
+func (upperHexCodec) Decode(in []byte) (UUID, error) {
+	s := string(in)
+	return Parse(s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:])
+}
+func (upperHexCodec) Detect(in []byte) bool { return len(in) == 32 }
+
+func TestParseAny(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	tests := []struct {
+		name      string
+		in        []byte
+		want      UUID
+		wantCodec string
+		wantErr   bool
+	}{
+		{"Canonical", []byte("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"), id, "canonical", false},
+		{"Binary", id[:], id, "binary", false},
+		{"OID", []byte("2.25.329800735698586629295641978511506172918"), id, "oid", false},
+		{"OIDURN", []byte("urn:oid:2.25.329800735698586629295641978511506172918"), id, "oid", false},
+		{"Base32", []byte("7R3N7TWZFC278AES80M34HWTZP"), id, "base32", false},
+		{"InvalidCanonical", []byte("g81d4fae-7dec-11d0-a765-00a0c91e6bf6"), UUID{}, "", true},
+		{"Unknown", []byte("f81d4fae7dec11d0a76500a0c91e6bf6"), UUID{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, codec, err := ParseAny(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAny() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseAny() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && codec.Name() != tt.wantCodec {
+				t.Errorf("ParseAny() codec = %v, want %v", codec.Name(), tt.wantCodec)
+			}
+		})
+	}
+}
+
+func TestParseAnyText(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	if got, codec, err := ParseAnyText([]byte("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")); err != nil || got != id || codec.Name() != "canonical" {
+		t.Errorf("ParseAnyText() = %v, %v, %v", got, codec, err)
+	}
+	if got, _, err := ParseAnyText([]byte("abcdefghijklmnop")); err == nil {
+		t.Errorf("ParseAnyText() = %v, expected error for 16 characters of text", got)
+	}
+	if _, codec, err := ParseAny([]byte("abcdefghijklmnop")); err != nil || codec.Name() != "binary" {
+		t.Errorf("ParseAny() = %v, %v, want binary codec", codec, err)
+	}
+}
+
+func TestRegisterCodec(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	if err := RegisterCodec(upperHexCodec{}, 50); err != nil {
+		t.Fatalf("RegisterCodec() error = %v", err)
+	}
+	defer UnregisterCodec("upperhex")
+
+	if err := RegisterCodec(upperHexCodec{}, 50); err == nil {
+		t.Errorf("RegisterCodec() expected error for duplicate name")
+	}
+	if c, ok := LookupCodec("upperhex"); !ok || c.Name() != "upperhex" {
+		t.Errorf("LookupCodec() = %v, %v", c, ok)
+	}
+	got, codec, err := ParseAny([]byte("F81D4FAE7DEC11D0A76500A0C91E6BF6"))
+	if err != nil || got != id || codec.Name() != "upperhex" {
+		t.Errorf("ParseAny() = %v, %v, %v", got, codec, err)
+	}
+	if enc := codec.Encode(id); !bytes.Equal(enc, []byte("F81D4FAE7DEC11D0A76500A0C91E6BF6")) {
+		t.Errorf("Codec.Encode() = %s", enc)
+	}
+
+	names := []string{}
+	for _, c := range Codecs() {
+		names = append(names, c.Name())
+	}
+	if want := "canonical,binary,oid,base32,upperhex"; strings.Join(names, ",") != want {
+		t.Errorf("Codecs() = %v, want %v", names, want)
+	}
+	if !UnregisterCodec("upperhex") || UnregisterCodec("upperhex") {
+		t.Errorf("UnregisterCodec() did not remove codec exactly once")
+	}
+	if _, ok := LookupCodec("upperhex"); ok {
+		t.Errorf("LookupCodec() found unregistered codec")
+	}
+}
+
+func TestBuiltinCodecs(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	for _, c := range []Codec{CanonicalCodec, URNCodec, HexCodec, BinaryCodec, OIDCodec, Base32Codec, Base64Codec, Base58Codec, Base62Codec} {
+		t.Run(c.Name(), func(t *testing.T) {
+			enc := c.Encode(id)
+			if !c.Detect(enc) {
+				t.Errorf("Codec.Detect(%s) = false", enc)
+			}
+			got, err := c.Decode(enc)
+			if err != nil || got != id {
+				t.Errorf("Codec.Decode(%s) = %v, %v, want %v", enc, got, err, id)
+			}
+		})
+	}
+}
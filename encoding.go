@@ -0,0 +1,226 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+const (
+	base32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"                               // Crockford base32 as used by ULID
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"     // Bitcoin base58
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" // ASCII ordered base62
+
+	base64Length = 22
+	base32Length = 26
+	base58Length = 22
+	base62Length = 22
+)
+
+var (
+	base32Decode = newDecodeMap(base32Alphabet)
+	base58Decode = newDecodeMap(base58Alphabet)
+	base62Decode = newDecodeMap(base62Alphabet)
+)
+
+func init() {
+	// Crockford base32 decoding is case-insensitive and maps commonly confused characters
+	for i, c := range base32Alphabet {
+		if 'A' <= c && c <= 'Z' {
+			base32Decode[c+'a'-'A'] = byte(i)
+		}
+	}
+	base32Decode['I'], base32Decode['i'] = 1, 1
+	base32Decode['L'], base32Decode['l'] = 1, 1
+	base32Decode['O'], base32Decode['o'] = 0, 0
+}
+
+func newDecodeMap(alphabet string) (m [256]byte) {
+	for i := range m {
+		m[i] = 0xFF
+	}
+	for i := 0; i < len(alphabet); i++ {
+		m[alphabet[i]] = byte(i)
+	}
+	return
+}
+
+// Base64 returns the UUID encoded as 22 characters of unpadded base64url (RFC 4648 Section 5).
+// The encoding is URL and filename safe but does not preserve sort order.
+func (uuid UUID) Base64() string {
+	return string(uuid.AppendBase64(make([]byte, 0, base64Length)))
+}
+
+// AppendBase64 appends the base64url encoding of the UUID to dst and returns the extended buffer.
+func (uuid UUID) AppendBase64(dst []byte) []byte {
+	return base64.RawURLEncoding.AppendEncode(dst, uuid[:])
+}
+
+// ParseBase64 parses 22 characters of unpadded base64url as a UUID returning either the resulting UUID or an error
+func ParseBase64(str string) (uuid UUID, err error) {
+	if len(str) != base64Length {
+		return UUID{}, fmt.Errorf("invalid length for base64 UUID: %d", len(str))
+	}
+	if _, err = base64.RawURLEncoding.Strict().Decode(uuid[:], []byte(str)); err != nil {
+		return UUID{}, fmt.Errorf("UUID did contain unexpected character in base64: %w", err)
+	}
+	return uuid, nil
+}
+
+// Base32 returns the UUID encoded as 26 characters of Crockford base32 using the ULID alphabet.
+// The encoding preserves the byte order of UUIDs, so v6 and v7 UUIDs remain sortable as strings.
+func (uuid UUID) Base32() string {
+	return string(uuid.AppendBase32(make([]byte, 0, base32Length)))
+}
+
+// AppendBase32 appends the Crockford base32 encoding of the UUID to dst and returns the extended buffer.
+func (uuid UUID) AppendBase32(dst []byte) []byte {
+	hi, lo := uuid.uint128()
+	// 26 characters hold 130 bits, so the first character only encodes the top 3 bits
+	for shift := 125; shift >= 0; shift -= 5 {
+		dst = append(dst, base32Alphabet[shr128(hi, lo, uint(shift))&0x1F])
+	}
+	return dst
+}
+
+// ParseBase32 parses 26 characters of Crockford base32 as a UUID returning either the resulting UUID or an error
+// Decoding is case-insensitive and the characters I, L and O are accepted as aliases for 1, 1 and 0.
+func ParseBase32(str string) (UUID, error) {
+	if len(str) != base32Length {
+		return UUID{}, fmt.Errorf("invalid length for base32 UUID: %d", len(str))
+	}
+	var hi, lo uint64
+	for i := 0; i < len(str); i++ {
+		d := base32Decode[str[i]]
+		if d == 0xFF {
+			return UUID{}, fmt.Errorf("UUID did contain unexpected character in base32 at position %d", i)
+		}
+		if i == 0 && d > 7 {
+			return UUID{}, fmt.Errorf("base32 UUID exceeds 128 bits")
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(d)
+	}
+	return fromUint128(hi, lo), nil
+}
+
+// Base58 returns the UUID encoded as 22 characters of base58 using the Bitcoin alphabet.
+// The output is padded to a fixed width, so the encoding preserves the byte order of UUIDs.
+func (uuid UUID) Base58() string {
+	return string(uuid.AppendBase58(make([]byte, 0, base58Length)))
+}
+
+// AppendBase58 appends the base58 encoding of the UUID to dst and returns the extended buffer.
+func (uuid UUID) AppendBase58(dst []byte) []byte {
+	return uuid.appendBaseN(dst, base58Alphabet, base58Length)
+}
+
+// ParseBase58 parses 22 characters of base58 as a UUID returning either the resulting UUID or an error
+func ParseBase58(str string) (UUID, error) {
+	return parseBaseN(str, &base58Decode, 58, base58Length, "base58")
+}
+
+// Base62 returns the UUID encoded as 22 characters of base62 using the alphabet 0-9, A-Z, a-z.
+// The output is padded to a fixed width, so the encoding preserves the byte order of UUIDs.
+func (uuid UUID) Base62() string {
+	return string(uuid.AppendBase62(make([]byte, 0, base62Length)))
+}
+
+// AppendBase62 appends the base62 encoding of the UUID to dst and returns the extended buffer.
+func (uuid UUID) AppendBase62(dst []byte) []byte {
+	return uuid.appendBaseN(dst, base62Alphabet, base62Length)
+}
+
+// ParseBase62 parses 22 characters of base62 as a UUID returning either the resulting UUID or an error
+func ParseBase62(str string) (UUID, error) {
+	return parseBaseN(str, &base62Decode, 62, base62Length, "base62")
+}
+
+// appendBaseN appends the UUID as a fixed width number in the base given by the length of alphabet.
+func (uuid UUID) appendBaseN(dst []byte, alphabet string, width int) []byte {
+	base := uint64(len(alphabet))
+	hi, lo := uuid.uint128()
+	dst = append(dst, make([]byte, width)...)
+	out := dst[len(dst)-width:]
+	for i := width - 1; i >= 0; i-- {
+		var rem uint64
+		hi, rem = bits.Div64(0, hi, base)
+		lo, rem = bits.Div64(rem, lo, base)
+		out[i] = alphabet[rem]
+	}
+	return dst
+}
+
+// parseBaseN parses a fixed width number in the provided base as a UUID.
+func parseBaseN(str string, decode *[256]byte, base uint64, width int, name string) (UUID, error) {
+	if len(str) != width {
+		return UUID{}, fmt.Errorf("invalid length for %s UUID: %d", name, len(str))
+	}
+	var hi, lo uint64
+	for i := 0; i < len(str); i++ {
+		d := decode[str[i]]
+		if d == 0xFF {
+			return UUID{}, fmt.Errorf("UUID did contain unexpected character in %s at position %d", name, i)
+		}
+		// hi:lo = hi:lo * base + d
+		overflow, hiMul := bits.Mul64(hi, base)
+		carry, loMul := bits.Mul64(lo, base)
+		var c uint64
+		lo, c = bits.Add64(loMul, uint64(d), 0)
+		hi, c = bits.Add64(hiMul, carry, c)
+		if overflow != 0 || c != 0 {
+			return UUID{}, fmt.Errorf("%s UUID exceeds 128 bits", name)
+		}
+	}
+	return fromUint128(hi, lo), nil
+}
+
+// uint128 returns the UUID as the high and low 64 bits of an unsigned 128-bit big-endian integer.
+func (uuid UUID) uint128() (hi, lo uint64) {
+	return binary.BigEndian.Uint64(uuid[:8]), binary.BigEndian.Uint64(uuid[8:])
+}
+
+func fromUint128(hi, lo uint64) (uuid UUID) {
+	binary.BigEndian.PutUint64(uuid[:8], hi)
+	binary.BigEndian.PutUint64(uuid[8:], lo)
+	return
+}
+
+// shr128 returns the low 64 bits of hi:lo shifted right by n bits.
+func shr128(hi, lo uint64, n uint) uint64 {
+	switch {
+	case n == 0:
+		return lo
+	case n < 64:
+		return lo>>n | hi<<(64-n)
+	default:
+		return hi >> (n - 64)
+	}
+}
+
+// shl128 returns v shifted left by n bits as the high and low 64 bits of an unsigned 128-bit integer.
+func shl128(v uint64, n uint) (hi, lo uint64) {
+	switch {
+	case n == 0:
+		return 0, v
+	case n < 64:
+		return v >> (64 - n), v << n
+	default:
+		return v << (n - 64), 0
+	}
+}
+
+// getBits returns the n bits (at most 64) of hi:lo starting at bit off counted from the least significant bit.
+func getBits(hi, lo uint64, off, n uint) uint64 {
+	return shr128(hi, lo, off) & (^uint64(0) >> (64 - n))
+}
+
+// setBits sets the n bits (at most 64) of hi:lo starting at bit off counted from the least significant bit to v.
+func setBits(hi, lo *uint64, off, n uint, v uint64) {
+	m := ^uint64(0) >> (64 - n)
+	mh, ml := shl128(m, off)
+	vh, vl := shl128(v&m, off)
+	*hi = *hi&^mh | vh
+	*lo = *lo&^ml | vl
+}
@@ -0,0 +1,173 @@
+package uuid
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+var encodingTests = []struct {
+	name   string
+	uuid   UUID
+	base64 string
+	base32 string
+	base58 string
+	base62 string
+}{
+	{"X667", UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, "-B1Prn3sEdCnZQCgyR5r9g", "7R3N7TWZFC278AES80M34HWTZP", "Xe22UfxT3rxcKJEAfL5373", "7YBUWgZR1mKSqGyj9tVViw"},
+	{"Nil", UUID{}, "AAAAAAAAAAAAAAAAAAAAAA", "00000000000000000000000000", "1111111111111111111111", "0000000000000000000000"},
+	{"Max", UUID{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, "_____________________w", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "YcVfxkQb6JRzqk5kF2tNLv", "7n42DGM5Tflk9n8mt7Fhc7"},
+	{"RFC9562V7", UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}, "AX8i4nmwfMOYxNwMDAc5jw", "01FWHE4YDGFK1SHH6W1G60EECF", "1BihbxwwQ4NZZpKRH9JDCz", "02p5oQZoHTv0zeY5yG21K3"},
+}
+
+func TestUUID_Encodings(t *testing.T) {
+	for _, tt := range encodingTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.Base64(); got != tt.base64 {
+				t.Errorf("UUID.Base64() = %v, want %v", got, tt.base64)
+			}
+			if got := tt.uuid.Base32(); got != tt.base32 {
+				t.Errorf("UUID.Base32() = %v, want %v", got, tt.base32)
+			}
+			if got := tt.uuid.Base58(); got != tt.base58 {
+				t.Errorf("UUID.Base58() = %v, want %v", got, tt.base58)
+			}
+			if got := tt.uuid.Base62(); got != tt.base62 {
+				t.Errorf("UUID.Base62() = %v, want %v", got, tt.base62)
+			}
+		})
+	}
+}
+
+func TestParseEncodings(t *testing.T) {
+	for _, tt := range encodingTests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsers := []struct {
+				name  string
+				parse func(string) (UUID, error)
+				in    string
+			}{
+				{"ParseBase64", ParseBase64, tt.base64},
+				{"ParseBase32", ParseBase32, tt.base32},
+				{"ParseBase58", ParseBase58, tt.base58},
+				{"ParseBase62", ParseBase62, tt.base62},
+			}
+			for _, p := range parsers {
+				got, err := p.parse(p.in)
+				if err != nil {
+					t.Errorf("%s() error = %v", p.name, err)
+					continue
+				}
+				if got != tt.uuid {
+					t.Errorf("%s() = %v, want %v", p.name, got, tt.uuid)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEncodingsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) (UUID, error)
+		in    string
+	}{
+		{"Base64Length", ParseBase64, "AAAA"},
+		{"Base64Character", ParseBase64, "AAAAAAAAAAAAAAAAAAAA+A"},
+		{"Base64TrailingBits", ParseBase64, "_____________________x"},
+		{"Base32Length", ParseBase32, "0000"},
+		{"Base32Character", ParseBase32, "0000000000000000000000000U"},
+		{"Base32Overflow", ParseBase32, "80000000000000000000000000"},
+		{"Base58Length", ParseBase58, "1111"},
+		{"Base58Character", ParseBase58, "111111111111111111111l"},
+		{"Base58Overflow", ParseBase58, "zzzzzzzzzzzzzzzzzzzzzz"},
+		{"Base62Length", ParseBase62, "0000"},
+		{"Base62Character", ParseBase62, "000000000000000000000-"},
+		{"Base62Overflow", ParseBase62, "7n42DGM5Tflk9n8mt7Fhc8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.parse(tt.in); err == nil {
+				t.Errorf("expected error for %q", tt.in)
+			}
+		})
+	}
+}
+
+func TestParseBase32Aliases(t *testing.T) {
+	want := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	got, err := ParseBase32("o1fwhe4ydgfkishh6wLg60eecf")
+	if err != nil {
+		t.Fatalf("ParseBase32() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("ParseBase32() = %v, want %v", got, want)
+	}
+}
+
+func TestEncodingsSortable(t *testing.T) {
+	ids := []UUID{
+		{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F},
+		{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB1, 0x70, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		{0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x1E, 0xC9, 0x41, 0x4C, 0x23, 0x2A, 0x6B, 0x00, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46},
+	}
+	slices.SortFunc(ids, func(a, b UUID) int { return slices.Compare(a[:], b[:]) })
+	encoders := map[string]func(UUID) string{"Base32": UUID.Base32, "Base58": UUID.Base58, "Base62": UUID.Base62}
+	for name, encode := range encoders {
+		strs := make([]string, len(ids))
+		for i, id := range ids {
+			strs[i] = encode(id)
+		}
+		if !sort.StringsAreSorted(strs) {
+			t.Errorf("%s encoding does not preserve sort order: %v", name, strs)
+		}
+	}
+}
+
+func TestUUID_AppendEncodingsAllocs(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	buf := make([]byte, 0, 32)
+	allocs := testing.AllocsPerRun(100, func() {
+		id.AppendBase64(buf)
+		id.AppendBase32(buf)
+		id.AppendBase58(buf)
+		id.AppendBase62(buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Append encodings allocated %v times, want 0", allocs)
+	}
+}
+
+func TestBits(t *testing.T) {
+	tests := []struct {
+		name   string
+		off, n uint
+		v      uint64
+		hi, lo uint64
+	}{
+		{"Low", 0, 8, 0xAB, 0, 0xAB},
+		{"Straddle", 60, 8, 0xAB, 0xA, 0xB000000000000000},
+		{"High", 64, 64, 0x0123456789ABCDEF, 0x0123456789ABCDEF, 0},
+		{"Full", 0, 64, ^uint64(0), 0, ^uint64(0)},
+		{"Truncated", 4, 4, 0xFF, 0, 0xF0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hi, lo uint64
+			setBits(&hi, &lo, tt.off, tt.n, tt.v)
+			if hi != tt.hi || lo != tt.lo {
+				t.Errorf("setBits() = %x:%x, want %x:%x", hi, lo, tt.hi, tt.lo)
+			}
+			if got, want := getBits(hi, lo, tt.off, tt.n), tt.v&(^uint64(0)>>(64-tt.n)); got != want {
+				t.Errorf("getBits() = %x, want %x", got, want)
+			}
+			hi, lo = ^uint64(0), ^uint64(0)
+			setBits(&hi, &lo, tt.off, tt.n, 0)
+			if getBits(hi, lo, tt.off, tt.n) != 0 || (tt.off+tt.n < 128 && shr128(hi, lo, tt.off+tt.n)&1 != 1) || (tt.off > 0 && lo&1 != 1) {
+				t.Errorf("setBits() did not clear exactly the field: %x:%x", hi, lo)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package uuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// encrypterKeyIDBits is the number of bits of an encrypted UUID holding the key ID
+const encrypterKeyIDBits = 2
+
+// MaxEncrypterKeyID is the highest key ID supported by Encrypter
+const MaxEncrypterKeyID = 1<<encrypterKeyIDBits - 1
+
+// encrypterRounds is the number of Feistel rounds used by Encrypter
+const encrypterRounds = 8
+
+// mask60 selects one half of the 120 bits permuted by Encrypter
+const mask60 = 1<<60 - 1
+
+// Encrypter maps UUIDv6 or UUIDv7 to opaque UUIDs labeled as version 4 and back using AES-128.
+// This hides the timestamp and node embedded in them while keeping them as keys internally.
+//
+// A UUID has 122 bits besides its version and variant. The top 2 of them hold the ID of the key used for encryption,
+// so the corresponding bits of the plaintext must be zero. This is the case for UUIDv6 until the year 2495 and for UUIDv7 until the year 4199.
+// Other versions are not supported, as their leading bits are random.
+// The remaining 120 bits are permuted using a balanced Feistel network with 8 rounds of AES-128 as round function, like FF3-1,
+// which makes the mapping a keyed permutation that can only be reversed with the key. Every call takes exactly 8 AES operations.
+// An Encrypter is safe for concurrent use.
+type Encrypter struct {
+	version byte
+	current int
+	keys    [MaxEncrypterKeyID + 1]cipher.Block
+}
+
+// NewEncrypter returns an Encrypter for UUIDs of the provided version, which must be 6 or 7.
+// keys maps key IDs between 0 and MaxEncrypterKeyID to 16-byte AES-128 keys and current selects the key used for encryption.
+// All keys can be used for decryption, so keys can be rotated by adding a new key and making it current.
+func NewEncrypter(version int, current int, keys map[int][]byte) (*Encrypter, error) {
+	if version != 6 && version != 7 {
+		return nil, fmt.Errorf("unsupported version %d, expected 6 or 7", version)
+	}
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("current key ID %d has no key", current)
+	}
+	e := &Encrypter{version: byte(version), current: current}
+	for id, key := range keys {
+		if id < 0 || id > MaxEncrypterKeyID {
+			return nil, fmt.Errorf("key ID %d out of range 0 to %d", id, MaxEncrypterKeyID)
+		}
+		if len(key) != 16 {
+			return nil, fmt.Errorf("key %d must be 16 bytes long, got %d", id, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		e.keys[id] = block
+	}
+	return e, nil
+}
+
+// Encrypt returns the opaque UUIDv4 for the UUID using the current key.
+// An error is returned if the UUID is not of the version of the Encrypter or its top 2 bits are not zero.
+func (e *Encrypter) Encrypt(uuid UUID) (UUID, error) {
+	if uuid.Variant() != VariantRFC9562 || uuid.Version() != int(e.version) {
+		return UUID{}, fmt.Errorf("expected UUID of version %d", e.version)
+	}
+	hi, lo := uuid.payload()
+	if hi>>(58-encrypterKeyIDBits) != 0 {
+		return UUID{}, errors.New("UUID is out of range for encryption")
+	}
+	l, r := hi<<4|lo>>60, lo&mask60
+	for round := range encrypterRounds {
+		l, r = r, l^encrypterRound(e.keys[e.current], round, r)
+	}
+	out := fromPayload(uint64(e.current)<<(58-encrypterKeyIDBits)|l>>4, l<<60|r)
+	out.setVersion(4)
+	return out, nil
+}
+
+// Decrypt returns the UUID that was encrypted to the opaque UUIDv4 using the key identified within it.
+func (e *Encrypter) Decrypt(uuid UUID) (UUID, error) {
+	if uuid.Variant() != VariantRFC9562 || uuid.Version() != 4 {
+		return UUID{}, errors.New("expected UUID of version 4")
+	}
+	hi, lo := uuid.payload()
+	id := hi >> (58 - encrypterKeyIDBits)
+	if e.keys[id] == nil {
+		return UUID{}, fmt.Errorf("unknown key ID %d", id)
+	}
+	l, r := (hi&(1<<(58-encrypterKeyIDBits)-1))<<4|lo>>60, lo&mask60
+	for round := encrypterRounds - 1; round >= 0; round-- {
+		l, r = r^encrypterRound(e.keys[id], round, l), l
+	}
+	out := fromPayload(l>>4, l<<60|r)
+	out.setVersion(e.version)
+	return out, nil
+}
+
+// encrypterRound is the Feistel round function returning 60 pseudorandom bits for the round number and half
+func encrypterRound(key cipher.Block, round int, half uint64) uint64 {
+	var block [aes.BlockSize]byte
+	block[0] = byte(round)
+	binary.BigEndian.PutUint64(block[8:], half)
+	key.Encrypt(block[:], block[:])
+	return binary.BigEndian.Uint64(block[8:]) & mask60
+}
+
+// payload returns the 122 bits of the UUID besides version and variant as the high 58 and low 64 bits of an integer.
+func (uuid UUID) payload() (hi, lo uint64) {
+	h, l := uuid.uint128()
+	a, b, c := h>>16, h&0xFFF, l&(1<<62-1) // 48 bits before the version, 12 bits after it and 62 bits after the variant
+	return a<<10 | b>>2, b<<62 | c
+}
+
+// fromPayload is the inverse of payload. Version and variant bits are left as zero.
+func fromPayload(hi, lo uint64) UUID {
+	a, b, c := hi>>10, (hi&0x3FF)<<2|lo>>62, lo&(1<<62-1)
+	return fromUint128(a<<16|b, c)
+}
@@ -0,0 +1,129 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+var (
+	testEncKey0 = bytes.Repeat([]byte{0x00}, 16)
+	testEncKey1 = []byte("0123456789abcdef")
+)
+
+func TestNewEncrypter(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		current int
+		keys    map[int][]byte
+		wantErr bool
+	}{
+		{"Valid", 7, 0, map[int][]byte{0: testEncKey0}, false},
+		{"Rotation", 6, 1, map[int][]byte{0: testEncKey0, 1: testEncKey1}, false},
+		{"InvalidVersion", 9, 0, map[int][]byte{0: testEncKey0}, true},
+		{"RandomVersion", 4, 0, map[int][]byte{0: testEncKey0}, true},
+		{"NameBasedVersion", 5, 0, map[int][]byte{0: testEncKey0}, true},
+		{"MissingCurrent", 7, 1, map[int][]byte{0: testEncKey0}, true},
+		{"KeyIDOutOfRange", 7, 0, map[int][]byte{0: testEncKey0, 4: testEncKey1}, true},
+		{"ShortKey", 7, 0, map[int][]byte{0: testEncKey0[:15]}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEncrypter(tt.version, tt.current, tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewEncrypter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncrypter(t *testing.T) {
+	e, err := NewEncrypter(7, 1, map[int][]byte{0: testEncKey0, 1: testEncKey1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	enc, err := e.Encrypt(id)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if enc.Version() != 4 || enc.Variant() != VariantRFC9562 {
+		t.Errorf("Encrypt() = %v, want version 4 and RFC 9562 variant", enc)
+	}
+	if enc[0]>>6 != 1 {
+		t.Errorf("Encrypt() = %v, want key ID 1 in the top bits", enc)
+	}
+	dec, err := e.Decrypt(enc)
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if dec != id {
+		t.Errorf("Decrypt() = %v, want %v", dec, id)
+	}
+
+	// Vary every payload bit except the top 2 reserved for the key ID
+	for i := 2; i < 128; i++ {
+		if i == 48 || i == 49 || i == 50 || i == 51 || i == 64 || i == 65 {
+			continue
+		}
+		in := id
+		in[i/8] ^= 0x80 >> (i % 8)
+		enc, err := e.Encrypt(in)
+		if err != nil {
+			t.Fatalf("Encrypt(%v) error = %v", in, err)
+		}
+		dec, err := e.Decrypt(enc)
+		if err != nil || dec != in {
+			t.Errorf("Decrypt(Encrypt(%v)) = %v, %v", in, dec, err)
+		}
+	}
+
+	// Older keys remain usable for decryption after rotation
+	old, _ := NewEncrypter(7, 0, map[int][]byte{0: testEncKey0})
+	encOld, _ := old.Encrypt(id)
+	if encOld == enc {
+		t.Errorf("Encrypt() with different keys returned the same UUID")
+	}
+	if dec, err := e.Decrypt(encOld); err != nil || dec != id {
+		t.Errorf("Decrypt() of UUID encrypted with old key = %v, %v, want %v", dec, err, id)
+	}
+	if _, err := old.Decrypt(enc); err == nil {
+		t.Errorf("Decrypt() with unknown key ID did not return an error")
+	}
+}
+
+func TestEncrypter_Errors(t *testing.T) {
+	e, _ := NewEncrypter(7, 0, map[int][]byte{0: testEncKey0})
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"EncryptWrongVersion", func() error {
+			_, err := e.Encrypt(UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x4C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F})
+			return err
+		}},
+		{"EncryptV4", func() error {
+			_, err := e.Encrypt(NewV4())
+			return err
+		}},
+		{"EncryptWrongVariant", func() error {
+			_, err := e.Encrypt(UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x18, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F})
+			return err
+		}},
+		{"EncryptOutOfRange", func() error {
+			_, err := e.Encrypt(UUID{0x41, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F})
+			return err
+		}},
+		{"DecryptWrongVersion", func() error {
+			_, err := e.Decrypt(UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.fn() == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
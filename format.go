@@ -0,0 +1,251 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"strings"
+)
+
+// Hex is a UUID that uses 32 lowercase hex digits without hyphens as its text representation.
+// It converts freely to and from UUID and can be used as a struct field to change the marshaled format.
+type Hex UUID
+
+// URN is a UUID that uses the URN form urn:uuid:<canonical> as its text representation.
+// It converts freely to and from UUID and can be used as a struct field to change the marshaled format.
+type URN UUID
+
+// Upper is a UUID that uses the canonical form with uppercase hex digits as its text representation.
+// It converts freely to and from UUID and can be used as a struct field to change the marshaled format.
+type Upper UUID
+
+// Base64 is a UUID that uses 22 characters of unpadded base64url as its text representation.
+// It converts freely to and from UUID and can be used as a struct field to change the marshaled format.
+type Base64 UUID
+
+// scanText implements database/sql.Scanner for the format types.
+// Byte slices of length 16 are treated as binary UUIDs, all other values are parsed using parse.
+func scanText(val any, parse func(string) (UUID, error)) (uuid UUID, err error) {
+	switch v := val.(type) {
+	case []byte:
+		if len(v) == 16 {
+			copy(uuid[:], v)
+			return uuid, nil
+		}
+		return parse(string(v))
+	case string:
+		return parse(v)
+	default:
+		return UUID{}, fmt.Errorf("unknown type %T", v)
+	}
+}
+
+// String returns the hex representation of the UUID
+func (id Hex) String() string {
+	return UUID(id).Hex()
+}
+
+// MarshalText provides encoding.TextMarshaler
+func (id Hex) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText provides encoding.TextUnmarshaler
+func (id *Hex) UnmarshalText(in []byte) error {
+	uuid, err := ParseHex(string(in))
+	if err != nil {
+		return err
+	}
+	*id = Hex(uuid)
+	return nil
+}
+
+// MarshalBinary provides encoding.BinaryMarshaler
+func (id Hex) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary provides encoding.BinaryUnmarshaler
+func (id *Hex) UnmarshalBinary(in []byte) error {
+	return (*UUID)(id).UnmarshalBinary(in)
+}
+
+// Scan provides database/sql.Scanner
+func (id *Hex) Scan(val any) error {
+	uuid, err := scanText(val, ParseHex)
+	if err != nil {
+		return err
+	}
+	*id = Hex(uuid)
+	return nil
+}
+
+// Value provides database/sql/driver.Valuer
+func (id Hex) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+// String returns the URN representation of the UUID
+func (id URN) String() string {
+	return UUID(id).URN()
+}
+
+// MarshalText provides encoding.TextMarshaler
+func (id URN) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText provides encoding.TextUnmarshaler
+func (id *URN) UnmarshalText(in []byte) error {
+	uuid, err := ParseURN(string(in))
+	if err != nil {
+		return err
+	}
+	*id = URN(uuid)
+	return nil
+}
+
+// MarshalBinary provides encoding.BinaryMarshaler
+func (id URN) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary provides encoding.BinaryUnmarshaler
+func (id *URN) UnmarshalBinary(in []byte) error {
+	return (*UUID)(id).UnmarshalBinary(in)
+}
+
+// Scan provides database/sql.Scanner
+func (id *URN) Scan(val any) error {
+	uuid, err := scanText(val, ParseURN)
+	if err != nil {
+		return err
+	}
+	*id = URN(uuid)
+	return nil
+}
+
+// Value provides database/sql/driver.Valuer
+func (id URN) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+// String returns the uppercase canonical representation of the UUID
+func (id Upper) String() string {
+	return strings.ToUpper(UUID(id).String())
+}
+
+// MarshalText provides encoding.TextMarshaler
+func (id Upper) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText provides encoding.TextUnmarshaler
+// Parsing is case-insensitive, so lowercase input is accepted as well.
+func (id *Upper) UnmarshalText(in []byte) error {
+	uuid, err := Parse(string(in))
+	if err != nil {
+		return err
+	}
+	*id = Upper(uuid)
+	return nil
+}
+
+// MarshalBinary provides encoding.BinaryMarshaler
+func (id Upper) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary provides encoding.BinaryUnmarshaler
+func (id *Upper) UnmarshalBinary(in []byte) error {
+	return (*UUID)(id).UnmarshalBinary(in)
+}
+
+// Scan provides database/sql.Scanner
+func (id *Upper) Scan(val any) error {
+	uuid, err := scanText(val, Parse)
+	if err != nil {
+		return err
+	}
+	*id = Upper(uuid)
+	return nil
+}
+
+// Value provides database/sql/driver.Valuer
+func (id Upper) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+// String returns the base64url representation of the UUID
+func (id Base64) String() string {
+	return UUID(id).Base64()
+}
+
+// MarshalText provides encoding.TextMarshaler
+func (id Base64) MarshalText() ([]byte, error) {
+	return UUID(id).AppendBase64(nil), nil
+}
+
+// UnmarshalText provides encoding.TextUnmarshaler
+func (id *Base64) UnmarshalText(in []byte) error {
+	uuid, err := ParseBase64(string(in))
+	if err != nil {
+		return err
+	}
+	*id = Base64(uuid)
+	return nil
+}
+
+// MarshalBinary provides encoding.BinaryMarshaler
+func (id Base64) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary provides encoding.BinaryUnmarshaler
+func (id *Base64) UnmarshalBinary(in []byte) error {
+	return (*UUID)(id).UnmarshalBinary(in)
+}
+
+// Scan provides database/sql.Scanner
+func (id *Base64) Scan(val any) error {
+	uuid, err := scanText(val, ParseBase64)
+	if err != nil {
+		return err
+	}
+	*id = Base64(uuid)
+	return nil
+}
+
+// Value provides database/sql/driver.Valuer
+func (id Base64) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+var _ encoding.TextMarshaler = (*Hex)(nil)
+var _ encoding.TextUnmarshaler = (*Hex)(nil)
+var _ encoding.BinaryMarshaler = (*Hex)(nil)
+var _ encoding.BinaryUnmarshaler = (*Hex)(nil)
+var _ sql.Scanner = (*Hex)(nil)
+var _ driver.Valuer = (*Hex)(nil)
+
+var _ encoding.TextMarshaler = (*URN)(nil)
+var _ encoding.TextUnmarshaler = (*URN)(nil)
+var _ encoding.BinaryMarshaler = (*URN)(nil)
+var _ encoding.BinaryUnmarshaler = (*URN)(nil)
+var _ sql.Scanner = (*URN)(nil)
+var _ driver.Valuer = (*URN)(nil)
+
+var _ encoding.TextMarshaler = (*Upper)(nil)
+var _ encoding.TextUnmarshaler = (*Upper)(nil)
+var _ encoding.BinaryMarshaler = (*Upper)(nil)
+var _ encoding.BinaryUnmarshaler = (*Upper)(nil)
+var _ sql.Scanner = (*Upper)(nil)
+var _ driver.Valuer = (*Upper)(nil)
+
+var _ encoding.TextMarshaler = (*Base64)(nil)
+var _ encoding.TextUnmarshaler = (*Base64)(nil)
+var _ encoding.BinaryMarshaler = (*Base64)(nil)
+var _ encoding.BinaryUnmarshaler = (*Base64)(nil)
+var _ sql.Scanner = (*Base64)(nil)
+var _ driver.Valuer = (*Base64)(nil)
@@ -0,0 +1,113 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type formatValue interface {
+	encoding.TextMarshaler
+	encoding.BinaryMarshaler
+	driver.Valuer
+}
+
+type formatPointer interface {
+	encoding.TextUnmarshaler
+	encoding.BinaryUnmarshaler
+	sql.Scanner
+}
+
+func TestFormatTypes(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	hexID, urnID, upperID, base64ID := Hex(id), URN(id), Upper(id), Base64(id)
+	tests := []struct {
+		name  string
+		value formatValue
+		ptr   func() (formatPointer, func() UUID)
+		text  string
+	}{
+		{"Hex", hexID, func() (formatPointer, func() UUID) { var v Hex; return &v, func() UUID { return UUID(v) } }, "f81d4fae7dec11d0a76500a0c91e6bf6"},
+		{"URN", urnID, func() (formatPointer, func() UUID) { var v URN; return &v, func() UUID { return UUID(v) } }, "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		{"Upper", upperID, func() (formatPointer, func() UUID) { var v Upper; return &v, func() UUID { return UUID(v) } }, "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"},
+		{"Base64", base64ID, func() (formatPointer, func() UUID) { var v Base64; return &v, func() UUID { return UUID(v) } }, "-B1Prn3sEdCnZQCgyR5r9g"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.value.MarshalText(); string(got) != tt.text {
+				t.Errorf("MarshalText() = %s, want %s", got, tt.text)
+			}
+			if got := tt.value.(interface{ String() string }).String(); got != tt.text {
+				t.Errorf("String() = %s, want %s", got, tt.text)
+			}
+			if got, _ := tt.value.MarshalBinary(); !reflect.DeepEqual(got, id[:]) {
+				t.Errorf("MarshalBinary() = %v, want %v", got, id[:])
+			}
+			if got, _ := tt.value.Value(); got != tt.text {
+				t.Errorf("Value() = %v, want %v", got, tt.text)
+			}
+
+			inputs := []struct {
+				name      string
+				unmarshal func(formatPointer) error
+			}{
+				{"UnmarshalText", func(p formatPointer) error { return p.UnmarshalText([]byte(tt.text)) }},
+				{"UnmarshalBinary", func(p formatPointer) error { return p.UnmarshalBinary(id[:]) }},
+				{"ScanString", func(p formatPointer) error { return p.Scan(tt.text) }},
+				{"ScanTextBytes", func(p formatPointer) error { return p.Scan([]byte(tt.text)) }},
+				{"ScanBinary", func(p formatPointer) error { return p.Scan(id[:]) }},
+			}
+			for _, in := range inputs {
+				p, get := tt.ptr()
+				if err := in.unmarshal(p); err != nil {
+					t.Errorf("%s() error = %v", in.name, err)
+					continue
+				}
+				if get() != id {
+					t.Errorf("%s() = %v, want %v", in.name, get(), id)
+				}
+			}
+
+			p, _ := tt.ptr()
+			if err := p.UnmarshalText([]byte("invalid")); err == nil {
+				t.Errorf("UnmarshalText() expected error for invalid input")
+			}
+			if err := p.UnmarshalBinary([]byte{0x01}); err == nil {
+				t.Errorf("UnmarshalBinary() expected error for invalid input")
+			}
+			if err := p.Scan(123); err == nil {
+				t.Errorf("Scan() expected error for unsupported type")
+			}
+		})
+	}
+}
+
+func TestFormatTypesJSON(t *testing.T) {
+	type record struct {
+		ID    UUID   `json:"id"`
+		Hex   Hex    `json:"hex"`
+		URN   URN    `json:"urn"`
+		Upper Upper  `json:"upper"`
+		B64   Base64 `json:"b64"`
+	}
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	in := record{id, Hex(id), URN(id), Upper(id), Base64(id)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"id":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6","hex":"f81d4fae7dec11d0a76500a0c91e6bf6","urn":"urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6","upper":"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6","b64":"-B1Prn3sEdCnZQCgyR5r9g"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+	var out record
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if out != in {
+		t.Errorf("json.Unmarshal() = %v, want %v", out, in)
+	}
+}
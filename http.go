@@ -0,0 +1,166 @@
+package uuid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DefaultRequestIDHeader is the header used by RequestID and RequestIDTransport if none is configured
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// contextKey is the type of the key used to store UUIDs in a context
+type contextKey struct{}
+
+// WithID returns a copy of ctx carrying the provided UUID
+func WithID(ctx context.Context, uuid UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, uuid)
+}
+
+// FromContext returns the UUID carried by ctx and whether one was present
+func FromContext(ctx context.Context) (UUID, bool) {
+	uuid, ok := ctx.Value(contextKey{}).(UUID)
+	return uuid, ok
+}
+
+// ValidRequestID is the default validation policy for incoming request IDs.
+// It accepts UUIDs of the RFC 9562 variant with a version between 1 and 8 and rejects the Nil and Max UUIDs.
+func ValidRequestID(uuid UUID) bool {
+	return uuid.Variant() == VariantRFC9562 && uuid.Version() >= 1 && uuid.Version() <= 8
+}
+
+// RequestIDOptions configures the RequestID middleware
+type RequestIDOptions struct {
+	// Header is the name of the request and response header and defaults to DefaultRequestIDHeader
+	Header string
+	// Generate returns a new request ID if none was provided or the provided one was rejected and defaults to NewV7
+	Generate func() UUID
+	// Parse parses the incoming header and defaults to Parse
+	Parse func(string) (UUID, error)
+	// Validate decides whether a parsed incoming request ID is accepted and defaults to ValidRequestID
+	Validate func(UUID) bool
+}
+
+// RequestID returns a net/http middleware that assigns a request ID to every request.
+// An incoming request ID header is accepted only if it parses and passes validation, otherwise a new ID is generated.
+// The ID is stored in the request context, where it can be retrieved using FromContext, and set as response header.
+// If opts is nil, the defaults described in RequestIDOptions are used.
+func RequestID(opts *RequestIDOptions) func(http.Handler) http.Handler {
+	var o RequestIDOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Header == "" {
+		o.Header = DefaultRequestIDHeader
+	}
+	if o.Generate == nil {
+		o.Generate = NewV7
+	}
+	if o.Parse == nil {
+		o.Parse = Parse
+	}
+	if o.Validate == nil {
+		o.Validate = ValidRequestID
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id, err := o.Parse(r.Header.Get(o.Header))
+			if err != nil || !o.Validate(id) {
+				id = o.Generate()
+			}
+			w.Header().Set(o.Header, id.String())
+			next.ServeHTTP(w, r.WithContext(WithID(r.Context(), id)))
+		})
+	}
+}
+
+// RequestIDTransport is a net/http.RoundTripper that propagates the UUID carried by the request context as a header on outgoing requests.
+// Requests whose context carries no UUID or that already have the header set are passed on unchanged.
+type RequestIDTransport struct {
+	// Base is the RoundTripper used to send requests and defaults to http.DefaultTransport
+	Base http.RoundTripper
+	// Header is the name of the header and defaults to DefaultRequestIDHeader
+	Header string
+}
+
+// RoundTrip provides net/http.RoundTripper
+func (t *RequestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	header := t.Header
+	if header == "" {
+		header = DefaultRequestIDHeader
+	}
+	if id, ok := FromContext(req.Context()); ok && req.Header.Get(header) == "" {
+		req = req.Clone(req.Context()) // RoundTrippers must not modify the request
+		req.Header.Set(header, id.String())
+	}
+	return base.RoundTrip(req)
+}
+
+// PathValue parses the named path wildcard of a request matched by net/http.ServeMux as a UUID
+func PathValue(r *http.Request, name string) (UUID, error) {
+	return parseParam("path", name, r.PathValue(name))
+}
+
+// QueryValue parses the named query parameter as a UUID
+func QueryValue(r *http.Request, name string) (UUID, error) {
+	return parseParam("query", name, r.URL.Query().Get(name))
+}
+
+// FormValue parses the named form value as a UUID
+// See net/http.Request.FormValue for details on how the value is retrieved.
+func FormValue(r *http.Request, name string) (UUID, error) {
+	return parseParam("form", name, r.FormValue(name))
+}
+
+func parseParam(kind, name, value string) (UUID, error) {
+	if value == "" {
+		return UUID{}, fmt.Errorf("missing %s parameter %q", kind, name)
+	}
+	uuid, err := Parse(value)
+	if err != nil {
+		return UUID{}, fmt.Errorf("invalid UUID in %s parameter %q: %w", kind, name, err)
+	}
+	return uuid, nil
+}
+
+// PathHandler returns a net/http.Handler that parses the named path wildcard as a UUID and passes it to h.
+// If the wildcard is missing or not a valid UUID, a 400 Bad Request response is written using WriteBadRequest.
+func PathHandler(name string, h func(w http.ResponseWriter, r *http.Request, uuid UUID)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid, err := PathValue(r, name)
+		if err != nil {
+			WriteBadRequest(w, err)
+			return
+		}
+		h(w, r, uuid)
+	})
+}
+
+// problem is a problem details object as defined in RFC 9457
+type problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// WriteBadRequest writes a 400 Bad Request response containing an RFC 9457 problem details object (application/problem+json) describing err
+func WriteBadRequest(w http.ResponseWriter, err error) {
+	body, _ := json.Marshal(problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+		Detail: err.Error(),
+	})
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write(body)
+}
+
+var _ http.RoundTripper = (*RequestIDTransport)(nil)
@@ -0,0 +1,187 @@
+package uuid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	if _, ok := FromContext(context.Background()); ok {
+		t.Errorf("FromContext() found UUID in empty context")
+	}
+	got, ok := FromContext(WithID(context.Background(), id))
+	if !ok || got != id {
+		t.Errorf("FromContext() = %v, %v, want %v, true", got, ok, id)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	generated := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	tests := []struct {
+		name     string
+		opts     *RequestIDOptions
+		header   string
+		incoming string
+		want     string
+	}{
+		{"Generated", nil, "X-Request-ID", "", ""},
+		{"AcceptValid", nil, "X-Request-ID", "919108f7-52d1-4320-9bac-f847db4148a8", "919108f7-52d1-4320-9bac-f847db4148a8"},
+		{"RejectInvalid", nil, "X-Request-ID", "not-a-uuid", ""},
+		{"RejectNil", nil, "X-Request-ID", "00000000-0000-0000-0000-000000000000", ""},
+		{"RejectMax", nil, "X-Request-ID", "ffffffff-ffff-ffff-ffff-ffffffffffff", ""},
+		{"CustomGenerator", &RequestIDOptions{Generate: func() UUID { return generated }}, "X-Request-ID", "", generated.String()},
+		{"CustomHeader", &RequestIDOptions{Header: "X-Correlation-ID"}, "X-Correlation-ID", "919108f7-52d1-4320-9bac-f847db4148a8", "919108f7-52d1-4320-9bac-f847db4148a8"},
+		{"CustomValidate", &RequestIDOptions{Validate: func(id UUID) bool { return id.Version() == 7 }}, "X-Request-ID", "919108f7-52d1-4320-9bac-f847db4148a8", ""},
+		{"CustomParse", &RequestIDOptions{Parse: ParseHex}, "X-Request-ID", "919108f752d143209bacf847db4148a8", "919108f7-52d1-4320-9bac-f847db4148a8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxID UUID
+			handler := RequestID(tt.opts)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var ok bool
+				if ctxID, ok = FromContext(r.Context()); !ok {
+					t.Errorf("RequestID() did not store UUID in context")
+				}
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(tt.header, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(tt.header)
+			if got != ctxID.String() {
+				t.Errorf("RequestID() response header = %v, context = %v", got, ctxID)
+			}
+			if tt.want != "" && got != tt.want {
+				t.Errorf("RequestID() = %v, want %v", got, tt.want)
+			}
+			if tt.want == "" && (got == tt.incoming || !ValidRequestID(ctxID)) {
+				t.Errorf("RequestID() = %v, want newly generated ID", got)
+			}
+		})
+	}
+}
+
+// roundTripFunc allows using a function as http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestRequestIDTransport(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	tests := []struct {
+		name     string
+		header   string
+		ctx      context.Context
+		existing string
+		want     string
+	}{
+		{"Propagate", "", WithID(context.Background(), id), "", id.String()},
+		{"CustomHeader", "X-Correlation-ID", WithID(context.Background(), id), "", id.String()},
+		{"NoID", "", context.Background(), "", ""},
+		{"KeepExisting", "", WithID(context.Background(), id), "existing", "existing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == "" {
+				header = DefaultRequestIDHeader
+			}
+			var got string
+			transport := &RequestIDTransport{
+				Header: tt.header,
+				Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					got = req.Header.Get(header)
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				}),
+			}
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil).WithContext(tt.ctx)
+			if tt.existing != "" {
+				req.Header.Set(header, tt.existing)
+			}
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatalf("RequestIDTransport.RoundTrip() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RequestIDTransport.RoundTrip() header = %v, want %v", got, tt.want)
+			}
+			if tt.existing == "" && req.Header.Get(header) != "" {
+				t.Errorf("RequestIDTransport.RoundTrip() modified the original request")
+			}
+		})
+	}
+}
+
+func TestRequestValues(t *testing.T) {
+	id := UUID{0x91, 0x91, 0x08, 0xF7, 0x52, 0xD1, 0x43, 0x20, 0x9B, 0xAC, 0xF8, 0x47, 0xDB, 0x41, 0x48, 0xA8}
+	tests := []struct {
+		name    string
+		get     func(*http.Request, string) (UUID, error)
+		value   string
+		want    UUID
+		wantErr bool
+	}{
+		{"PathValid", PathValue, id.String(), id, false},
+		{"PathInvalid", PathValue, "not-a-uuid", UUID{}, true},
+		{"PathMissing", PathValue, "", UUID{}, true},
+		{"QueryValid", QueryValue, id.String(), id, false},
+		{"QueryInvalid", QueryValue, "not-a-uuid", UUID{}, true},
+		{"QueryMissing", QueryValue, "", UUID{}, true},
+		{"FormValid", FormValue, id.String(), id, false},
+		{"FormInvalid", FormValue, "not-a-uuid", UUID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.value != "" {
+				req = httptest.NewRequest(http.MethodGet, "/?id="+tt.value, nil)
+				req.SetPathValue("id", tt.value)
+			}
+			got, err := tt.get(req, "id")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /items/{id}", PathHandler("id", func(w http.ResponseWriter, r *http.Request, id UUID) {
+		w.Write([]byte(id.String()))
+	}))
+	tests := []struct {
+		name        string
+		path        string
+		wantStatus  int
+		wantBody    string
+		contentType string
+	}{
+		{"Valid", "/items/919108f7-52d1-4320-9bac-f847db4148a8", http.StatusOK, "919108f7-52d1-4320-9bac-f847db4148a8", ""},
+		{"Invalid", "/items/not-a-uuid", http.StatusBadRequest, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid UUID in path parameter \"id\": invalid length for UUID: 10"}`, "application/problem+json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %v, want %v", rec.Body.String(), tt.wantBody)
+			}
+			if tt.contentType != "" && rec.Header().Get("Content-Type") != tt.contentType {
+				t.Errorf("Content-Type = %v, want %v", rec.Header().Get("Content-Type"), tt.contentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// intMapperRounds is the number of Feistel rounds used by IntMapper
+const intMapperRounds = 8
+
+// mask61 selects one half of the 122 bits permuted by IntMapper
+const mask61 = 1<<61 - 1
+
+// IntMapper deterministically maps 64-bit integers such as legacy auto-increment keys to UUIDv8 and back.
+// Existing rows get stable UUIDs without a mapping table while the integers stay hidden from anyone without the key.
+//
+// The 122 bits of a UUID besides version and variant hold the integer and 58 bits of the SHA-256 hash of a tag,
+// such as the table name, permuted using a Feistel network with 8 rounds of AES as round function.
+// The tag separates the UUIDs of different tables and lets Int reject UUIDs that were not created for the same tag and key.
+// An IntMapper is safe for concurrent use.
+type IntMapper struct {
+	block cipher.Block
+}
+
+// NewIntMapper returns an IntMapper using the provided AES key, which must be 16, 24 or 32 bytes long.
+func NewIntMapper(key []byte) (*IntMapper, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &IntMapper{block: block}, nil
+}
+
+// UUID returns the UUIDv8 for the integer and tag
+func (m *IntMapper) UUID(id int64, tag string) UUID {
+	l, r := tagHash(tag)<<3|uint64(id)>>61, uint64(id)&mask61
+	for round := range intMapperRounds {
+		l, r = r, l^m.round(round, r)
+	}
+	uuid := fromPayload(l>>3, l<<61|r)
+	uuid.setVersion(8)
+	return uuid
+}
+
+// Int returns the integer the UUID was created for.
+// An error is returned if the UUID was not created by UUID using the same tag and key.
+func (m *IntMapper) Int(uuid UUID, tag string) (int64, error) {
+	if uuid.Variant() != VariantRFC9562 || uuid.Version() != 8 {
+		return 0, errors.New("expected UUID of version 8")
+	}
+	hi, lo := uuid.payload()
+	l, r := hi<<3|lo>>61, lo&mask61
+	for round := intMapperRounds - 1; round >= 0; round-- {
+		l, r = r^m.round(round, l), l
+	}
+	if l>>3 != tagHash(tag) {
+		return 0, errors.New("UUID was not created for this tag and key")
+	}
+	return int64(l<<61 | r), nil
+}
+
+// round is the Feistel round function returning 61 pseudorandom bits for the round number and half
+func (m *IntMapper) round(round int, half uint64) uint64 {
+	var block [aes.BlockSize]byte
+	block[0] = byte(round)
+	binary.BigEndian.PutUint64(block[8:], half)
+	m.block.Encrypt(block[:], block[:])
+	return binary.BigEndian.Uint64(block[8:]) & mask61
+}
+
+// tagHash returns the top 58 bits of the SHA-256 hash of the tag
+func tagHash(tag string) uint64 {
+	sum := sha256.Sum256([]byte(tag))
+	return binary.BigEndian.Uint64(sum[:]) >> 6
+}
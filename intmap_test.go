@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewIntMapper(t *testing.T) {
+	for _, n := range []int{15, 16, 24, 32, 33} {
+		_, err := NewIntMapper(make([]byte, n))
+		if wantErr := n != 16 && n != 24 && n != 32; (err != nil) != wantErr {
+			t.Errorf("NewIntMapper() with %d byte key error = %v, wantErr %v", n, err, wantErr)
+		}
+	}
+}
+
+func TestIntMapper(t *testing.T) {
+	m, _ := NewIntMapper([]byte("0123456789abcdef"))
+	seen := make(map[UUID]int64)
+	for _, id := range []int64{0, 1, 2, 3, 42, 1000, 1 << 32, math.MaxInt64, -1, math.MinInt64} {
+		for _, tag := range []string{"", "users", "orders"} {
+			uuid := m.UUID(id, tag)
+			if uuid.Version() != 8 || uuid.Variant() != VariantRFC9562 {
+				t.Errorf("UUID(%d, %q) = %v, want version 8 and RFC 9562 variant", id, tag, uuid)
+			}
+			if uuid != m.UUID(id, tag) {
+				t.Errorf("UUID(%d, %q) is not deterministic", id, tag)
+			}
+			if prev, ok := seen[uuid]; ok {
+				t.Errorf("UUID(%d, %q) = %v collides with %d", id, tag, uuid, prev)
+			}
+			seen[uuid] = id
+			got, err := m.Int(uuid, tag)
+			if err != nil || got != id {
+				t.Errorf("Int(UUID(%d, %q)) = %d, %v", id, tag, got, err)
+			}
+		}
+	}
+}
+
+func TestIntMapper_Errors(t *testing.T) {
+	m, _ := NewIntMapper([]byte("0123456789abcdef"))
+	other, _ := NewIntMapper([]byte("fedcba9876543210"))
+	uuid := m.UUID(42, "users")
+	if _, err := m.Int(uuid, "orders"); err == nil {
+		t.Errorf("Int() with different tag did not return an error")
+	}
+	if _, err := other.Int(uuid, "users"); err == nil {
+		t.Errorf("Int() with different key did not return an error")
+	}
+	forged := uuid
+	forged[15] ^= 0x01
+	if _, err := m.Int(forged, "users"); err == nil {
+		t.Errorf("Int() of modified UUID did not return an error")
+	}
+	if _, err := m.Int(UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}, "users"); err == nil {
+		t.Errorf("Int() of UUIDv7 did not return an error")
+	}
+}
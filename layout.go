@@ -0,0 +1,285 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// payloadBits is the number of bits of a UUID besides version and variant
+const payloadBits = 122
+
+// FieldKind is the kind of a field of a Layout
+type FieldKind int
+
+const (
+	FieldTimestamp FieldKind = iota // Time since an epoch in a unit, defaults to the current time
+	FieldValue                      // Value provided by the caller, such as a shard or region ID
+	FieldCounter                    // Counter incremented for every UUID, wrapping around when full
+	FieldRandom                     // Cryptographically secure random bits
+	FieldHash                       // Leading bits of the SHA-256 hash of a value provided by the caller
+)
+
+// field is a field of a Layout
+type field struct {
+	name   string
+	kind   FieldKind
+	bits   uint
+	offset uint // offset of the least significant bit within the payload
+	epoch  time.Time
+	unit   time.Duration
+}
+
+// LayoutBuilder declares the fields of a Layout. Create one using NewLayout.
+type LayoutBuilder struct {
+	fields []field
+	err    error
+}
+
+// NewLayout returns a LayoutBuilder for a custom UUIDv8 layout.
+// Fields are placed in the order they are declared starting at the most significant bit, skipping the version and variant bits.
+// Each field can be at most 64 bits wide and all fields together at most 122 bits. Unused trailing bits are zero.
+func NewLayout() *LayoutBuilder {
+	return &LayoutBuilder{}
+}
+
+func (b *LayoutBuilder) add(f field) *LayoutBuilder {
+	if b.err != nil {
+		return b
+	}
+	if f.bits < 1 || f.bits > 64 {
+		b.err = fmt.Errorf("field %q must be between 1 and 64 bits wide", f.name)
+		return b
+	}
+	used := uint(0)
+	for _, prev := range b.fields {
+		if prev.name == f.name {
+			b.err = fmt.Errorf("duplicate field %q", f.name)
+			return b
+		}
+		used += prev.bits
+	}
+	if used+f.bits > payloadBits {
+		b.err = fmt.Errorf("field %q exceeds the %d available bits", f.name, payloadBits)
+		return b
+	}
+	f.offset = payloadBits - used - f.bits
+	b.fields = append(b.fields, f)
+	return b
+}
+
+// Timestamp adds a field holding the time since epoch in multiples of unit
+func (b *LayoutBuilder) Timestamp(name string, bits int, epoch time.Time, unit time.Duration) *LayoutBuilder {
+	if unit <= 0 && b.err == nil {
+		b.err = fmt.Errorf("field %q must have a positive unit", name)
+	}
+	return b.add(field{name: name, kind: FieldTimestamp, bits: uint(bits), epoch: epoch, unit: unit})
+}
+
+// Value adds a field holding a value provided to New, such as a shard or region ID
+func (b *LayoutBuilder) Value(name string, bits int) *LayoutBuilder {
+	return b.add(field{name: name, kind: FieldValue, bits: uint(bits)})
+}
+
+// Counter adds a field holding a counter that is incremented for every UUID created by New
+func (b *LayoutBuilder) Counter(name string, bits int) *LayoutBuilder {
+	return b.add(field{name: name, kind: FieldCounter, bits: uint(bits)})
+}
+
+// Random adds a field holding cryptographically secure random bits
+func (b *LayoutBuilder) Random(name string, bits int) *LayoutBuilder {
+	return b.add(field{name: name, kind: FieldRandom, bits: uint(bits)})
+}
+
+// Hash adds a field holding the leading bits of the SHA-256 hash of a []byte or string value provided to New
+func (b *LayoutBuilder) Hash(name string, bits int) *LayoutBuilder {
+	return b.add(field{name: name, kind: FieldHash, bits: uint(bits)})
+}
+
+// Build returns the Layout or the first error encountered while declaring fields
+func (b *LayoutBuilder) Build() (*Layout, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.fields) == 0 {
+		return nil, errors.New("layout has no fields")
+	}
+	return &Layout{fields: append([]field(nil), b.fields...)}, nil
+}
+
+// Layout encodes and decodes UUIDv8 made up of named bit fields.
+// A Layout is safe for concurrent use.
+type Layout struct {
+	fields  []field
+	counter atomic.Uint64
+}
+
+// New returns a new UUIDv8 with the fields set according to their kind.
+// values provides values by field name and is required for value and hash fields.
+// Value fields accept unsigned and non-negative signed integers and hash fields accept []byte and string.
+// Timestamp, counter and random fields are generated unless a value is provided,
+// in which case timestamp fields accept a time.Time or an integer in the unit of the field and the others an integer.
+// An error is returned if a required value is missing, has the wrong type or does not fit into its field.
+func (l *Layout) New(values map[string]any) (UUID, error) {
+	var hi, lo uint64
+	var count uint64
+	for _, f := range l.fields {
+		v, ok := values[f.name]
+		var bits uint64
+		var err error
+		switch {
+		case ok && f.kind == FieldHash:
+			bits, err = hashValue(v, f.bits)
+		case ok && f.kind == FieldTimestamp:
+			if t, isTime := v.(time.Time); isTime {
+				bits, err = f.since(t)
+			} else {
+				bits, err = uintValue(v)
+			}
+		case ok:
+			bits, err = uintValue(v)
+		case f.kind == FieldTimestamp:
+			bits, err = f.since(currentTime())
+		case f.kind == FieldCounter:
+			if count == 0 {
+				count = l.counter.Add(1)
+			}
+			bits = count - 1
+			if f.bits < 64 {
+				bits &= 1<<f.bits - 1
+			}
+		case f.kind == FieldRandom:
+			var buf [8]byte
+			randomSource(buf[:])
+			bits = binary.BigEndian.Uint64(buf[:])
+			if f.bits < 64 {
+				bits &= 1<<f.bits - 1
+			}
+		default:
+			return UUID{}, fmt.Errorf("missing value for field %q", f.name)
+		}
+		if err != nil {
+			return UUID{}, fmt.Errorf("field %q: %w", f.name, err)
+		}
+		if f.bits < 64 && bits>>f.bits != 0 {
+			return UUID{}, fmt.Errorf("field %q: value %d does not fit into %d bits", f.name, bits, f.bits)
+		}
+		setBits(&hi, &lo, f.offset, f.bits, bits)
+	}
+	uuid := fromPayload(hi, lo)
+	uuid.setVersion(8)
+	return uuid, nil
+}
+
+// Fields returns the raw values of all fields of the UUID by name
+func (l *Layout) Fields(uuid UUID) map[string]uint64 {
+	hi, lo := uuid.payload()
+	fields := make(map[string]uint64, len(l.fields))
+	for _, f := range l.fields {
+		fields[f.name] = getBits(hi, lo, f.offset, f.bits)
+	}
+	return fields
+}
+
+// Time returns the time stored in the named timestamp field of the UUID
+func (l *Layout) Time(uuid UUID, name string) (time.Time, error) {
+	hi, lo := uuid.payload()
+	for _, f := range l.fields {
+		if f.name == name {
+			if f.kind != FieldTimestamp {
+				return time.Time{}, fmt.Errorf("field %q is not a timestamp", name)
+			}
+			return f.time(getBits(hi, lo, f.offset, f.bits)), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown field %q", name)
+}
+
+// since returns the time since the epoch of the field in its unit.
+// Units dividing or multiplying a second are calculated exactly, others are limited to the range of time.Duration.
+func (f field) since(t time.Time) (uint64, error) {
+	if t.Before(f.epoch) {
+		return 0, errors.New("time is before epoch")
+	}
+	secs, nanos := uint64(t.Unix()-f.epoch.Unix()), t.Nanosecond()-f.epoch.Nanosecond()
+	if nanos < 0 {
+		secs, nanos = secs-1, nanos+int(time.Second)
+	}
+	switch {
+	case time.Second%f.unit == 0:
+		return secs*uint64(time.Second/f.unit) + uint64(nanos)/uint64(f.unit), nil
+	case f.unit%time.Second == 0:
+		return secs / uint64(f.unit/time.Second), nil
+	default:
+		return uint64(t.Sub(f.epoch) / f.unit), nil
+	}
+}
+
+// time is the inverse of since
+func (f field) time(v uint64) time.Time {
+	switch {
+	case time.Second%f.unit == 0:
+		perSec := uint64(time.Second / f.unit)
+		return time.Unix(f.epoch.Unix()+int64(v/perSec), int64(f.epoch.Nanosecond())+int64(v%perSec)*int64(f.unit))
+	case f.unit%time.Second == 0:
+		return time.Unix(f.epoch.Unix()+int64(v)*int64(f.unit/time.Second), int64(f.epoch.Nanosecond()))
+	default:
+		return f.epoch.Add(time.Duration(v) * f.unit)
+	}
+}
+
+// uintValue converts an integer value to uint64
+func uintValue(v any) (uint64, error) {
+	switch n := v.(type) {
+	case uint64:
+		return n, nil
+	case uint:
+		return uint64(n), nil
+	case uint32:
+		return uint64(n), nil
+	case uint16:
+		return uint64(n), nil
+	case uint8:
+		return uint64(n), nil
+	case int, int64, int32, int16, int8:
+		i := toInt64(n)
+		if i < 0 {
+			return 0, fmt.Errorf("negative value %d", i)
+		}
+		return uint64(i), nil
+	default:
+		return 0, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+func toInt64(v any) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	case int32:
+		return int64(n)
+	case int16:
+		return int64(n)
+	default:
+		return int64(n.(int8))
+	}
+}
+
+// hashValue returns the leading bits of the SHA-256 hash of a []byte or string value
+func hashValue(v any, bits uint) (uint64, error) {
+	var sum [sha256.Size]byte
+	switch b := v.(type) {
+	case []byte:
+		sum = sha256.Sum256(b)
+	case string:
+		sum = sha256.Sum256([]byte(b))
+	default:
+		return 0, fmt.Errorf("unsupported type %T", v)
+	}
+	return binary.BigEndian.Uint64(sum[:]) >> (64 - bits), nil
+}
@@ -0,0 +1,117 @@
+package uuid
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestLayoutBuilder(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	tests := []struct {
+		name    string
+		build   func() *LayoutBuilder
+		wantErr bool
+	}{
+		{"Valid", func() *LayoutBuilder {
+			return NewLayout().Timestamp("ts", 48, epoch, time.Millisecond).Value("shard", 10).Counter("seq", 12).Random("rand", 52)
+		}, false},
+		{"Empty", NewLayout, true},
+		{"TooWide", func() *LayoutBuilder { return NewLayout().Random("rand", 65) }, true},
+		{"ZeroWidth", func() *LayoutBuilder { return NewLayout().Value("v", 0) }, true},
+		{"TooManyBits", func() *LayoutBuilder { return NewLayout().Random("a", 64).Random("b", 59) }, true},
+		{"Duplicate", func() *LayoutBuilder { return NewLayout().Value("v", 8).Value("v", 8) }, true},
+		{"InvalidUnit", func() *LayoutBuilder { return NewLayout().Timestamp("ts", 48, epoch, 0) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.build().Build()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLayout(t *testing.T) {
+	testPrepare(testVecTimeRFC, bytes.Repeat([]byte{0xFF}, 64), 0, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB})
+	// The same fields as UUIDv7 with a 12-bit fraction and 62 random bits
+	v7, err := NewLayout().Timestamp("ms", 48, time.Unix(0, 0), time.Millisecond).Value("frac", 12).Random("rand", 62).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := v7.New(map[string]any{"frac": 0xCC3})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	want := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x8C, 0xC3, 0xBF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	if got != want {
+		t.Errorf("New() = %v, want %v", got, want)
+	}
+	fields := v7.Fields(got)
+	if fields["ms"] != 0x017F22E279B0 || fields["frac"] != 0xCC3 || fields["rand"] != 1<<62-1 {
+		t.Errorf("Fields() = %x", fields)
+	}
+	if ts, err := v7.Time(got, "ms"); err != nil || !ts.Equal(time.Unix(0, testVecTimeRFC)) {
+		t.Errorf("Time() = %v, %v, want %v", ts, err, time.Unix(0, testVecTimeRFC))
+	}
+	if _, err := v7.Time(got, "frac"); err == nil {
+		t.Errorf("Time() of value field did not return an error")
+	}
+	if _, err := v7.Time(got, "missing"); err == nil {
+		t.Errorf("Time() of unknown field did not return an error")
+	}
+}
+
+func TestLayout_New(t *testing.T) {
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	l, err := NewLayout().
+		Timestamp("ts", 40, epoch, 10*time.Millisecond).
+		Value("shard", 10).
+		Counter("seq", 4).
+		Hash("tenant", 16).
+		Random("rand", 52).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := epoch.Add(1234560 * time.Millisecond)
+	var prev uint64
+	for i := range 20 {
+		id, err := l.New(map[string]any{"ts": at, "shard": 513, "tenant": "acme"})
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if id.Version() != 8 || id.Variant() != VariantRFC9562 {
+			t.Errorf("New() = %v, want version 8 and RFC 9562 variant", id)
+		}
+		f := l.Fields(id)
+		if f["ts"] != 123456 || f["shard"] != 513 || f["tenant"] != 0x822B {
+			t.Errorf("Fields() = %x", f)
+		}
+		if i > 0 && f["seq"] != (prev+1)%16 {
+			t.Errorf("counter = %d after %d, want increment with wrap-around", f["seq"], prev)
+		}
+		prev = f["seq"]
+		if ts, _ := l.Time(id, "ts"); !ts.Equal(at) {
+			t.Errorf("Time() = %v, want %v", ts, at)
+		}
+	}
+
+	errs := []map[string]any{
+		{"ts": at, "tenant": "acme"},                              // missing value
+		{"ts": at, "shard": 1024, "tenant": "acme"},               // value too large
+		{"ts": at, "shard": -1, "tenant": "acme"},                 // negative value
+		{"ts": at, "shard": "1", "tenant": "acme"},                // wrong type
+		{"ts": at, "shard": 1},                                    // missing hash input
+		{"ts": at, "shard": 1, "tenant": 42},                      // wrong hash input type
+		{"ts": epoch.Add(-time.Second), "shard": 1, "tenant": ""}, // before epoch
+		{"ts": uint64(1) << 40, "shard": 1, "tenant": ""},         // raw timestamp too large
+	}
+	for _, values := range errs {
+		if _, err := l.New(values); err == nil {
+			t.Errorf("New(%v) did not return an error", values)
+		}
+	}
+}
@@ -5,9 +5,20 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// oidPrefix is the OID arc for UUIDs as defined in ITU-T X.667 and RFC 9562
+const oidPrefix = "2.25."
+
+// urnPrefix is the URN namespace for UUIDs as defined in RFC 9562 Section 4
+const urnPrefix = "urn:uuid:"
+
 // Parse parses a string as a UUID returning either the resulting UUID or an error
 func Parse(str string) (UUID, error) {
 	if len(str) != 36 {
@@ -62,6 +73,153 @@ func (uuid UUID) String() string {
 	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
 }
 
+// Hex returns the UUID as 32 lowercase hex digits without hyphens
+func (uuid UUID) Hex() string {
+	return hex.EncodeToString(uuid[:])
+}
+
+// ParseHex parses 32 hex digits without hyphens as a UUID returning either the resulting UUID or an error
+func ParseHex(str string) (uuid UUID, err error) {
+	if len(str) != 32 {
+		return UUID{}, fmt.Errorf("invalid length for hex UUID: %d", len(str))
+	}
+	if _, err = hex.Decode(uuid[:], []byte(str)); err != nil {
+		return UUID{}, fmt.Errorf("UUID did contain unexpected character in hex")
+	}
+	return uuid, nil
+}
+
+// URN returns the UUID as a URN as defined in RFC 9562 Section 4 (urn:uuid:<canonical>)
+func (uuid UUID) URN() string {
+	return urnPrefix + uuid.String()
+}
+
+// ParseURN parses a UUID URN returning either the resulting UUID or an error
+// The "urn:uuid:" prefix is matched case-insensitively.
+func ParseURN(str string) (UUID, error) {
+	if len(str) < len(urnPrefix) || !strings.EqualFold(str[:len(urnPrefix)], urnPrefix) {
+		return UUID{}, fmt.Errorf("UUID URN must start with %s", urnPrefix)
+	}
+	return Parse(str[len(urnPrefix):])
+}
+
+// BigInt returns the UUID interpreted as an unsigned 128-bit big-endian integer
+func (uuid UUID) BigInt() *big.Int {
+	return new(big.Int).SetBytes(uuid[:])
+}
+
+// maxDecimalLength is the number of digits of the largest UUID as a decimal integer (2^128 - 1)
+const maxDecimalLength = 39
+
+// FromBigInt returns the UUID represented by an unsigned 128-bit integer or an error if the integer is nil, negative or too large
+func FromBigInt(i *big.Int) (uuid UUID, err error) {
+	if i == nil {
+		return UUID{}, errors.New("integer is nil")
+	}
+	if i.Sign() < 0 || i.BitLen() > 128 {
+		return UUID{}, fmt.Errorf("integer out of range for UUID: %s", i)
+	}
+	i.FillBytes(uuid[:])
+	return
+}
+
+// Decimal returns the UUID as an unsigned decimal integer as described in ITU-T X.667
+func (uuid UUID) Decimal() string {
+	return uuid.BigInt().String()
+}
+
+// ParseDecimal parses an unsigned decimal integer as a UUID returning either the resulting UUID or an error
+// Leading zeros are not permitted as required by ITU-T X.667
+func ParseDecimal(str string) (UUID, error) {
+	if len(str) > maxDecimalLength {
+		return UUID{}, fmt.Errorf("decimal UUID must not be longer than %d digits, got %d", maxDecimalLength, len(str))
+	}
+	if str == "" || (len(str) > 1 && str[0] == '0') || strings.TrimLeft(str, "0123456789") != "" {
+		return UUID{}, fmt.Errorf("invalid decimal UUID: %q", str)
+	}
+	i, _ := new(big.Int).SetString(str, 10)
+	return FromBigInt(i)
+}
+
+// OID returns the OID representation of the UUID as defined in ITU-T X.667 (2.25.<decimal>)
+func (uuid UUID) OID() string {
+	return oidPrefix + uuid.Decimal()
+}
+
+// ParseOID parses an OID in the 2.25 arc as a UUID returning either the resulting UUID or an error
+// The OID may optionally be prefixed with "urn:oid:" as defined in RFC 3061
+func ParseOID(str string) (UUID, error) {
+	if len(str) > 8 && strings.EqualFold(str[:8], "urn:oid:") {
+		str = str[8:]
+	}
+	if !strings.HasPrefix(str, oidPrefix) {
+		return UUID{}, fmt.Errorf("OID is not in the UUID arc %s", oidPrefix[:4])
+	}
+	return ParseDecimal(str[len(oidPrefix):])
+}
+
+// Format provides fmt.Formatter
+// The verbs %s and %v print the canonical representation, %x and %X print hex digits without hyphens,
+// %q prints the quoted canonical representation, %+v adds the version, variant and timestamp for debugging
+// and %#v prints a Go-syntax literal. Width and the - flag are supported for padding.
+func (uuid UUID) Format(f fmt.State, verb rune) {
+	var str string
+	switch verb {
+	case 's':
+		str = uuid.String()
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			str = uuid.GoString()
+		case f.Flag('+'):
+			str = uuid.verbose()
+		default:
+			str = uuid.String()
+		}
+	case 'x':
+		str = uuid.Hex()
+	case 'X':
+		str = strings.ToUpper(uuid.Hex())
+	case 'q':
+		str = strconv.Quote(uuid.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(uuid.UUID=%s)", verb, uuid.String())
+		return
+	}
+	if width, ok := f.Width(); ok && width > len(str) {
+		pad := strings.Repeat(" ", width-len(str))
+		if f.Flag('-') {
+			str += pad
+		} else {
+			str = pad + str
+		}
+	}
+	f.Write([]byte(str))
+}
+
+// GoString provides fmt.GoStringer
+func (uuid UUID) GoString() string {
+	var sb strings.Builder
+	sb.WriteString("uuid.UUID{")
+	for i, b := range uuid {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "0x%02x", b)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// verbose returns the canonical representation followed by a breakdown of the version, variant and timestamp
+func (uuid UUID) verbose() string {
+	str := fmt.Sprintf("%s (version: %d, variant: %s", uuid.String(), uuid.Version(), uuid.Variant())
+	if uuid.HasTimestamp() {
+		str += ", time: " + uuid.Timestamp().UTC().Format(time.RFC3339Nano)
+	}
+	return str + ")"
+}
+
 // MarshalText provides encoding.TextMarshaler
 func (uuid UUID) MarshalText() ([]byte, error) {
 	return []byte(uuid.String()), nil
@@ -117,6 +275,8 @@ func (uuid UUID) Value() (driver.Value, error) {
 	return uuid[:], nil
 }
 
+var _ fmt.Formatter = (*UUID)(nil)
+var _ fmt.GoStringer = (*UUID)(nil)
 var _ encoding.TextMarshaler = (*UUID)(nil)
 var _ encoding.TextUnmarshaler = (*UUID)(nil)
 var _ encoding.BinaryMarshaler = (*UUID)(nil)
@@ -1,7 +1,10 @@
 package uuid
 
 import (
+	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -241,3 +244,161 @@ func TestUUID_Value(t *testing.T) {
 		t.Errorf("UUID.Value() = %v, want %v", value, id[:])
 	}
 }
+
+func TestUUID_OID(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want string
+	}{
+		{"X667", UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, "2.25.329800735698586629295641978511506172918"},
+		{"Nil", UUID{}, "2.25.0"},
+		{"Max", UUID{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, "2.25.340282366920938463463374607431768211455"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.OID(); got != tt.want {
+				t.Errorf("UUID.OID() = %v, want %v", got, tt.want)
+			}
+			if got := tt.uuid.Decimal(); got != tt.want[5:] {
+				t.Errorf("UUID.Decimal() = %v, want %v", got, tt.want[5:])
+			}
+		})
+	}
+}
+
+func TestParseOID(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		wantUUID UUID
+		wantErr  bool
+	}{
+		{"X667", "2.25.329800735698586629295641978511506172918", UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, false},
+		{"URN", "urn:oid:2.25.329800735698586629295641978511506172918", UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, false},
+		{"Nil", "2.25.0", UUID{}, false},
+		{"WrongArc", "1.3.6.1.4.1", UUID{}, true},
+		{"LeadingZero", "2.25.0123", UUID{}, true},
+		{"Empty", "2.25.", UUID{}, true},
+		{"NonDigit", "2.25.12a3", UUID{}, true},
+		{"Negative", "2.25.-1", UUID{}, true},
+		{"Max", "2.25.340282366920938463463374607431768211455", UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, false},
+		{"TooLarge", "2.25.340282366920938463463374607431768211456", UUID{}, true},
+		{"TooLong", "2.25.1" + strings.Repeat("0", 100000), UUID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUUID, err := ParseOID(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotUUID, tt.wantUUID) {
+				t.Errorf("ParseOID() = %v, want %v", gotUUID, tt.wantUUID)
+			}
+		})
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	got, err := FromBigInt(id.BigInt())
+	if err != nil {
+		t.Fatalf("FromBigInt() error = %v", err)
+	}
+	if got != id {
+		t.Errorf("FromBigInt() = %v, want %v", got, id)
+	}
+	if _, err := FromBigInt(nil); err == nil {
+		t.Errorf("FromBigInt() expected error for nil integer")
+	}
+	if _, err := FromBigInt(big.NewInt(-1)); err == nil {
+		t.Errorf("FromBigInt() expected error for negative integer")
+	}
+	if _, err := FromBigInt(new(big.Int).Lsh(big.NewInt(1), 128)); err == nil {
+		t.Errorf("FromBigInt() expected error for integer exceeding 128 bits")
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		wantUUID UUID
+		wantErr  bool
+	}{
+		{"Normal", "686e7778f9f04622a13ec2441ce4ae41", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, false},
+		{"UpperCase", "686E7778F9F04622A13EC2441CE4AE41", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, false},
+		{"WrongLength", "686e7778f9f04622", UUID{}, true},
+		{"NonHexCharacters", "686e7778f9f04622a13ec2441ce4aexx", UUID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUUID, err := ParseHex(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotUUID, tt.wantUUID) {
+				t.Errorf("ParseHex() = %v, want %v", gotUUID, tt.wantUUID)
+			}
+		})
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		wantUUID UUID
+		wantErr  bool
+	}{
+		{"Normal", "urn:uuid:686e7778-f9f0-4622-a13e-c2441ce4ae41", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, false},
+		{"UpperCasePrefix", "URN:UUID:686e7778-f9f0-4622-a13e-c2441ce4ae41", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, false},
+		{"MissingPrefix", "686e7778-f9f0-4622-a13e-c2441ce4ae41", UUID{}, true},
+		{"InvalidUUID", "urn:uuid:686e7778-f9f0-4622", UUID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUUID, err := ParseURN(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseURN() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotUUID, tt.wantUUID) {
+				t.Errorf("ParseURN() = %v, want %v", gotUUID, tt.wantUUID)
+			}
+		})
+	}
+}
+
+func TestUUID_Format(t *testing.T) {
+	id := UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}
+	tests := []struct {
+		name   string
+		format string
+		value  any
+		want   string
+	}{
+		{"String", "%s", id, "c232ab00-9414-11ec-b3c8-9f6bdeced846"},
+		{"Value", "%v", id, "c232ab00-9414-11ec-b3c8-9f6bdeced846"},
+		{"Pointer", "%v", &id, "c232ab00-9414-11ec-b3c8-9f6bdeced846"},
+		{"Struct", "%v", struct{ ID UUID }{id}, "{c232ab00-9414-11ec-b3c8-9f6bdeced846}"},
+		{"Hex", "%x", id, "c232ab00941411ecb3c89f6bdeced846"},
+		{"UpperHex", "%X", id, "C232AB00941411ECB3C89F6BDECED846"},
+		{"Quoted", "%q", id, `"c232ab00-9414-11ec-b3c8-9f6bdeced846"`},
+		{"Verbose", "%+v", id, "c232ab00-9414-11ec-b3c8-9f6bdeced846 (version: 1, variant: RFC 9562, time: 2022-02-22T19:22:22Z)"},
+		{"VerboseNoTime", "%+v", UUID{0x91, 0x91, 0x08, 0xF7, 0x52, 0xD1, 0x43, 0x20, 0x9B, 0xAC, 0xF8, 0x47, 0xDB, 0x41, 0x48, 0xA8}, "919108f7-52d1-4320-9bac-f847db4148a8 (version: 4, variant: RFC 9562)"},
+		{"GoSyntax", "%#v", id, "uuid.UUID{0xc2, 0x32, 0xab, 0x00, 0x94, 0x14, 0x11, 0xec, 0xb3, 0xc8, 0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}"},
+		{"Width", "%40s|", id, "    c232ab00-9414-11ec-b3c8-9f6bdeced846|"},
+		{"LeftAlign", "%-40s|", id, "c232ab00-9414-11ec-b3c8-9f6bdeced846    |"},
+		{"BadVerb", "%d", id, "%!d(uuid.UUID=c232ab00-9414-11ec-b3c8-9f6bdeced846)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+				t.Errorf("fmt.Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Namespace represents a UUID used to derive name-based UUIDs and child namespaces.
+// It converts freely to and from UUID.
+type Namespace UUID
+
+// UUID returns the namespace as a UUID
+func (ns Namespace) UUID() UUID {
+	return UUID(ns)
+}
+
+// String returns the string representation of the namespace UUID
+func (ns Namespace) String() string {
+	return UUID(ns).String()
+}
+
+// Child returns a child namespace derived from ns and name using NewV5.
+// Child namespaces can be chained to build hierarchies like org → project → resource.
+func (ns Namespace) Child(name string) Namespace {
+	return Namespace(NewV5(UUID(ns), name))
+}
+
+// ChildSHA256 returns a child namespace derived from ns and name using NewV8SHA256.
+func (ns Namespace) ChildSHA256(name string) Namespace {
+	return Namespace(NewV8SHA256(UUID(ns), name))
+}
+
+// MarshalText provides encoding.TextMarshaler
+func (ns Namespace) MarshalText() ([]byte, error) {
+	return UUID(ns).MarshalText()
+}
+
+// UnmarshalText provides encoding.TextUnmarshaler
+// The text may either be a UUID in its canonical string representation or a name that is resolved using LookupNamespace.
+func (ns *Namespace) UnmarshalText(in []byte) error {
+	if id, err := Parse(string(in)); err == nil {
+		*ns = Namespace(id)
+		return nil
+	}
+	id, err := LookupNamespace(string(in))
+	if err != nil {
+		return err
+	}
+	*ns = id
+	return nil
+}
+
+// NamespaceRegistry maps human-readable names to namespaces.
+// Names are paths separated by "/" and names that are not registered are derived from their closest registered parent using Namespace.Child.
+// A NamespaceRegistry is safe for concurrent use.
+type NamespaceRegistry struct {
+	mu    sync.RWMutex
+	names map[string]Namespace
+}
+
+// NewNamespaceRegistry returns a new registry containing the predefined namespaces "dns", "url", "oid" and "x500".
+func NewNamespaceRegistry() *NamespaceRegistry {
+	return &NamespaceRegistry{names: map[string]Namespace{
+		"dns":  Namespace(NamespaceDNS()),
+		"url":  Namespace(NamespaceURL()),
+		"oid":  Namespace(NamespaceOID()),
+		"x500": Namespace(NamespaceX500()),
+	}}
+}
+
+// Register adds a namespace to the registry under the provided name.
+// Registering the same namespace twice is allowed, but registering a different namespace under an existing name returns an error.
+func (r *NamespaceRegistry) Register(name string, ns Namespace) error {
+	name = strings.Trim(name, "/")
+	if name == "" {
+		return fmt.Errorf("namespace name is empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.names[name]; ok && existing != ns {
+		return fmt.Errorf("namespace %q is already registered as %s", name, existing)
+	}
+	r.names[name] = ns
+	return nil
+}
+
+// Lookup returns the namespace registered under name.
+// If name is not registered, the namespace is derived from the longest registered prefix by calling Namespace.Child for each remaining path segment,
+// so with "billing" registered, "billing/invoices" resolves to the child "invoices" of "billing".
+// An error is returned if no prefix of name is registered.
+func (r *NamespaceRegistry) Lookup(name string) (Namespace, error) {
+	name = strings.Trim(name, "/")
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	prefix := name
+	for {
+		if ns, ok := r.names[prefix]; ok {
+			if len(prefix) < len(name) {
+				for _, segment := range strings.Split(name[len(prefix)+1:], "/") {
+					ns = ns.Child(segment)
+				}
+			}
+			return ns, nil
+		}
+		i := strings.LastIndexByte(prefix, '/')
+		if i < 0 {
+			return Namespace{}, fmt.Errorf("unknown namespace %q", name)
+		}
+		prefix = prefix[:i]
+	}
+}
+
+// defaultRegistry is the registry used by RegisterNamespace and LookupNamespace.
+var defaultRegistry = NewNamespaceRegistry()
+
+// RegisterNamespace adds a namespace to the default registry.
+// See NamespaceRegistry.Register for details.
+func RegisterNamespace(name string, ns Namespace) error {
+	return defaultRegistry.Register(name, ns)
+}
+
+// LookupNamespace returns a namespace from the default registry.
+// See NamespaceRegistry.Lookup for details.
+func LookupNamespace(name string) (Namespace, error) {
+	return defaultRegistry.Lookup(name)
+}
@@ -0,0 +1,106 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestNamespace_Child(t *testing.T) {
+	root := Namespace(NamespaceDNS())
+	if got, want := root.Child("www.example.com").UUID(), NewV5(NamespaceDNS(), "www.example.com"); got != want {
+		t.Errorf("Namespace.Child() = %v, want %v", got, want)
+	}
+	if got, want := root.ChildSHA256("www.example.com").UUID(), NewV8SHA256(NamespaceDNS(), "www.example.com"); got != want {
+		t.Errorf("Namespace.ChildSHA256() = %v, want %v", got, want)
+	}
+	if got, want := root.Child("a").Child("b"), Namespace(NewV5(NewV5(NamespaceDNS(), "a"), "b")); got != want {
+		t.Errorf("Namespace.Child().Child() = %v, want %v", got, want)
+	}
+	if got, want := root.String(), "6ba7b810-9dad-11d1-80b4-00c04fd430c8"; got != want {
+		t.Errorf("Namespace.String() = %v, want %v", got, want)
+	}
+}
+
+func TestNamespaceRegistry(t *testing.T) {
+	billing := Namespace{0x91, 0x91, 0x08, 0xF7, 0x52, 0xD1, 0x43, 0x20, 0x9B, 0xAC, 0xF8, 0x47, 0xDB, 0x41, 0x48, 0xA8}
+	r := NewNamespaceRegistry()
+	if err := r.Register("billing", billing); err != nil {
+		t.Fatalf("NamespaceRegistry.Register() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		lookup  string
+		want    Namespace
+		wantErr bool
+	}{
+		{"Predefined", "dns", Namespace(NamespaceDNS()), false},
+		{"Registered", "billing", billing, false},
+		{"RegisteredSlashes", "/billing/", billing, false},
+		{"Child", "billing/invoices", billing.Child("invoices"), false},
+		{"Grandchild", "billing/invoices/2024", billing.Child("invoices").Child("2024"), false},
+		{"Unknown", "shipping/labels", Namespace{}, true},
+		{"Empty", "", Namespace{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Lookup(tt.lookup)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NamespaceRegistry.Lookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NamespaceRegistry.Lookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := r.Register("billing", billing); err != nil {
+		t.Errorf("NamespaceRegistry.Register() error = %v for identical namespace", err)
+	}
+	if err := r.Register("billing", Namespace(NamespaceOID())); err == nil {
+		t.Errorf("NamespaceRegistry.Register() expected error for conflicting namespace")
+	}
+	if err := r.Register("/", billing); err == nil {
+		t.Errorf("NamespaceRegistry.Register() expected error for empty name")
+	}
+
+	override := Namespace(NamespaceX500())
+	if err := r.Register("billing/invoices", override); err != nil {
+		t.Fatalf("NamespaceRegistry.Register() error = %v", err)
+	}
+	if got, _ := r.Lookup("billing/invoices/2024"); got != override.Child("2024") {
+		t.Errorf("NamespaceRegistry.Lookup() = %v, want %v derived from longest prefix", got, override.Child("2024"))
+	}
+}
+
+func TestNamespace_UnmarshalText(t *testing.T) {
+	if err := RegisterNamespace("test-unmarshal", Namespace(NamespaceURL())); err != nil {
+		t.Fatalf("RegisterNamespace() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		in      string
+		want    Namespace
+		wantErr bool
+	}{
+		{"UUID", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Namespace(NamespaceDNS()), false},
+		{"Name", "test-unmarshal/child", Namespace(NamespaceURL()).Child("child"), false},
+		{"Unknown", "does-not-exist", Namespace{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ns Namespace
+			if err := ns.UnmarshalText([]byte(tt.in)); (err != nil) != tt.wantErr {
+				t.Errorf("Namespace.UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if ns != tt.want {
+				t.Errorf("Namespace.UnmarshalText() = %v, want %v", ns, tt.want)
+			}
+		})
+	}
+	text, err := Namespace(NamespaceDNS()).MarshalText()
+	if err != nil || string(text) != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("Namespace.MarshalText() = %s, %v", text, err)
+	}
+}
@@ -0,0 +1,306 @@
+package uuid
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// NewV5DNS returns a new UUIDv5 for the provided domain name in the DNS namespace.
+// The name is normalized using NormalizeDNS before hashing, so "Example.COM." and "example.com" result in the same UUID.
+func NewV5DNS(name string) (UUID, error) {
+	normalized, err := NormalizeDNS(name)
+	if err != nil {
+		return UUID{}, err
+	}
+	return NewV5(NamespaceDNS(), normalized), nil
+}
+
+// NewV5URL returns a new UUIDv5 for the provided URL in the URL namespace.
+// The URL is normalized using NormalizeURL before hashing, so URLs that only differ in case, default port or percent-encoding result in the same UUID.
+func NewV5URL(rawURL string) (UUID, error) {
+	normalized, err := NormalizeURL(rawURL)
+	if err != nil {
+		return UUID{}, err
+	}
+	return NewV5(NamespaceURL(), normalized), nil
+}
+
+// NormalizeDNS returns the normalized ASCII form of a domain name.
+// The name is lowercased, ideographic full stops are mapped to ".", any trailing dot is removed
+// and labels containing non-ASCII characters are converted to their Punycode form (IDNA ToASCII).
+// Input is expected to be in Unicode Normalization Form C as the full UTS #46 mapping is not applied.
+func NormalizeDNS(name string) (string, error) {
+	if !utf8.ValidString(name) {
+		return "", fmt.Errorf("domain name %q is not valid UTF-8", name)
+	}
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '。', '．', '｡': // ideographic, fullwidth and halfwidth ideographic full stop
+			return '.'
+		}
+		return r
+	}, strings.ToLower(name))
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return "", fmt.Errorf("domain name is empty")
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if label == "" {
+			return "", fmt.Errorf("domain name %q contains an empty label", name)
+		}
+		if !isASCII(label) {
+			label = "xn--" + punycodeEncode([]rune(label))
+		}
+		if len(label) > 63 {
+			return "", fmt.Errorf("domain name label %q exceeds 63 characters", label)
+		}
+		labels[i] = label
+	}
+	name = strings.Join(labels, ".")
+	if len(name) > 253 {
+		return "", fmt.Errorf("domain name exceeds 253 characters: %d", len(name))
+	}
+	return name, nil
+}
+
+// defaultPorts maps URL schemes to the port that is implied when none is given.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// NormalizeURL returns the normalized form of a URL according to RFC 3986 Section 6.2.2 and 6.2.3.
+// The scheme and host are lowercased, the host is normalized using NormalizeDNS, default ports are removed,
+// percent-encodings use uppercase hex digits and unreserved characters are decoded,
+// dot segments are removed from the path and an empty path is replaced by "/" if the URL has an authority.
+func NormalizeURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("URL %q is not absolute", rawURL)
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(u.Scheme))
+	sb.WriteByte(':')
+	if u.Opaque != "" {
+		sb.WriteString(normalizePercent(u.Opaque))
+	} else {
+		if u.Host != "" || u.User != nil {
+			sb.WriteString("//")
+			if u.User != nil {
+				sb.WriteString(normalizePercent(u.User.String()))
+				sb.WriteByte('@')
+			}
+			host := u.Hostname()
+			if strings.Contains(host, ":") {
+				sb.WriteString("[" + strings.ToLower(host) + "]") // IPv6 literal
+			} else if host != "" {
+				host, err = NormalizeDNS(host)
+				if err != nil {
+					return "", err
+				}
+				sb.WriteString(host)
+			}
+			if port := u.Port(); port != "" && port != defaultPorts[strings.ToLower(u.Scheme)] {
+				sb.WriteByte(':')
+				sb.WriteString(port)
+			}
+		}
+		path := removeDotSegments(normalizePercent(u.EscapedPath()))
+		if path == "" && u.Host != "" {
+			path = "/"
+		}
+		sb.WriteString(path)
+	}
+	if u.ForceQuery || u.RawQuery != "" {
+		sb.WriteByte('?')
+		sb.WriteString(normalizePercent(u.RawQuery))
+	}
+	if u.Fragment != "" {
+		sb.WriteByte('#')
+		sb.WriteString(normalizePercent(u.EscapedFragment()))
+	}
+	return sb.String(), nil
+}
+
+// normalizePercent decodes percent-encoded unreserved characters and uppercases the hex digits of all other percent-encodings.
+func normalizePercent(s string) string {
+	const upperhex = "0123456789ABCDEF"
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		c := unhex(s[i+1])<<4 | unhex(s[i+2])
+		if isUnreserved(c) {
+			sb.WriteByte(c)
+		} else {
+			sb.WriteByte('%')
+			sb.WriteByte(upperhex[c>>4])
+			sb.WriteByte(upperhex[c&0x0f])
+		}
+		i += 2
+	}
+	return sb.String()
+}
+
+// removeDotSegments removes "." and ".." segments from a path as described in RFC 3986 Section 5.2.4.
+func removeDotSegments(in string) string {
+	var out []string
+	for in != "" {
+		switch {
+		case strings.HasPrefix(in, "../"):
+			in = in[3:]
+		case strings.HasPrefix(in, "./"):
+			in = in[2:]
+		case strings.HasPrefix(in, "/./"):
+			in = in[2:]
+		case in == "/.":
+			in = "/"
+		case strings.HasPrefix(in, "/../"):
+			in = in[3:]
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "/..":
+			in = "/"
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "." || in == "..":
+			in = ""
+		default:
+			end := strings.IndexByte(in[1:], '/') + 1
+			if end == 0 {
+				end = len(in)
+			}
+			out = append(out, in[:end])
+			in = in[end:]
+		}
+	}
+	return strings.Join(out, "")
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// Punycode parameters as defined in RFC 3492 Section 5
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// punycodeEncode encodes a label using the Punycode algorithm described in RFC 3492 Section 6.3.
+func punycodeEncode(label []rune) string {
+	var out []byte
+	for _, r := range label {
+		if r < utf8.RuneSelf {
+			out = append(out, byte(r))
+		}
+	}
+	basic := len(out)
+	handled := basic
+	if basic > 0 {
+		out = append(out, '-')
+	}
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for handled < len(label) {
+		m := rune(utf8.MaxRune + 1)
+		for _, r := range label {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		delta += int(m-n) * (handled + 1)
+		n = m
+		for _, r := range label {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := min(max(k-bias, punyTMin), punyTMax)
+				if q < t {
+					break
+				}
+				out = append(out, punycodeDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punycodeDigit(q))
+			bias = punycodeAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return string(out)
+}
+
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punycodeAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
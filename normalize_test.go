@@ -0,0 +1,121 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestNormalizeDNS(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"Lowercase", "Example.COM", "example.com", false},
+		{"TrailingDot", "example.com.", "example.com", false},
+		{"IDN", "Bücher.example", "xn--bcher-kva.example", false},
+		{"IdeographicFullStop", "example。com", "example.com", false},
+		{"AlreadyPunycode", "xn--bcher-kva.example", "xn--bcher-kva.example", false},
+		{"Empty", "", "", true},
+		{"EmptyLabel", "example..com", "", true},
+		{"LabelTooLong", "a123456789012345678901234567890123456789012345678901234567890123.com", "", true},
+		{"InvalidUTF8", "ex\xffample.com", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeDNS(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NormalizeDNS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeDNS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"AlreadyNormal", "http://example.com/a?b=c#d", "http://example.com/a?b=c#d", false},
+		{"SchemeAndHostCase", "HTTP://www.Example.COM/Path", "http://www.example.com/Path", false},
+		{"DefaultPort", "https://example.com:443/", "https://example.com/", false},
+		{"NonDefaultPort", "https://example.com:8443/", "https://example.com:8443/", false},
+		{"EmptyPort", "http://example.com:/", "http://example.com/", false},
+		{"EmptyPath", "http://example.com", "http://example.com/", false},
+		{"PercentCase", "http://example.com/a%2fb?q=%3a", "http://example.com/a%2Fb?q=%3A", false},
+		{"PercentUnreserved", "http://example.com/%7Efoo%2D%41", "http://example.com/~foo-A", false},
+		{"DotSegments", "http://example.com/a/b/c/./../../g", "http://example.com/a/g", false},
+		{"TrailingDotHost", "http://example.com./", "http://example.com/", false},
+		{"IDNHost", "http://bücher.example/", "http://xn--bcher-kva.example/", false},
+		{"IPv6", "http://[2001:DB8::1]:80/", "http://[2001:db8::1]/", false},
+		{"UserInfo", "ftp://user@Example.com:21/file", "ftp://user@example.com/file", false},
+		{"Opaque", "URN:isbn:%7e0451450523", "urn:isbn:~0451450523", false},
+		{"Relative", "/relative/path", "", true},
+		{"Invalid", "http://[::1", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeURL(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NormalizeURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewV5DNS(t *testing.T) {
+	want := UUID{0x2E, 0xD6, 0x65, 0x7D, 0xE9, 0x27, 0x56, 0x8B, 0x95, 0xE1, 0x26, 0x65, 0xA8, 0xAE, 0xA6, 0xA2}
+	id, err := NewV5DNS("WWW.Example.com.")
+	if err != nil {
+		t.Fatalf("NewV5DNS() error = %v", err)
+	}
+	if id != want {
+		t.Errorf("NewV5DNS() = %v, want %v", id, want)
+	}
+	if _, err := NewV5DNS(""); err == nil {
+		t.Errorf("NewV5DNS() expected error for empty name")
+	}
+}
+
+func TestNewV5URL(t *testing.T) {
+	want := NewV5(NamespaceURL(), "http://www.example.com/~user/")
+	id, err := NewV5URL("HTTP://www.EXAMPLE.com:80/%7euser/./")
+	if err != nil {
+		t.Fatalf("NewV5URL() error = %v", err)
+	}
+	if id != want {
+		t.Errorf("NewV5URL() = %v, want %v", id, want)
+	}
+	if _, err := NewV5URL("relative"); err == nil {
+		t.Errorf("NewV5URL() expected error for relative URL")
+	}
+}
+
+func Test_punycodeEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"German", "bücher", "bcher-kva"},
+		{"Arabic", "ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+		{"Chinese", "他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := punycodeEncode([]rune(tt.in)); got != tt.want {
+				t.Errorf("punycodeEncode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// RedactMode controls how a Redactor replaces UUIDs
+type RedactMode int
+
+const (
+	RedactMask      RedactMode = iota // Replace UUIDs with a fixed mask
+	RedactPartial                     // Keep the first 8 hex digits and mask the rest
+	RedactPseudonym                   // Replace UUIDs with a keyed pseudonym that is stable for a given key
+)
+
+// partialMask replaces all but the first 8 hex digits of the canonical form for RedactPartial
+const partialMask = "-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+// RedactorOptions configures a Redactor
+type RedactorOptions struct {
+	// Mode controls how UUIDs are replaced
+	Mode RedactMode
+	// Mask replaces UUIDs in RedactMask mode and defaults to "[REDACTED]"
+	Mask string
+	// Key is the HMAC-SHA256 key used in RedactPseudonym mode and is required for it
+	Key []byte
+	// Version is the version of pseudonyms, either 8 (default) or 4
+	Version int
+}
+
+// Redactor finds UUIDs in text and replaces them according to its mode.
+// UUIDs are recognized in the forms described in Scanner and replacements keep the form of the original, except in RedactMask mode.
+// Pseudonyms are the first 16 bytes of the HMAC-SHA256 of the UUID with the version and variant bits overwritten
+// to label them with the configured version, so the same UUID always maps to the same pseudonym for a given key and redacted data can still be joined.
+// A Redactor is safe for concurrent use.
+type Redactor struct {
+	opts RedactorOptions
+}
+
+// NewRedactor returns a Redactor using the provided options.
+// An error is returned if the pseudonym mode is selected without a key or with a version other than 4 or 8.
+func NewRedactor(opts RedactorOptions) (*Redactor, error) {
+	if opts.Mask == "" {
+		opts.Mask = redactedPlaceholder
+	}
+	if opts.Version == 0 {
+		opts.Version = 8
+	}
+	if opts.Mode == RedactPseudonym {
+		if len(opts.Key) == 0 {
+			return nil, errors.New("pseudonym mode requires a key")
+		}
+		if opts.Version != 4 && opts.Version != 8 {
+			return nil, errors.New("pseudonym version must be 4 or 8")
+		}
+		opts.Key = append([]byte(nil), opts.Key...)
+	}
+	return &Redactor{opts: opts}, nil
+}
+
+// Pseudonym returns the keyed pseudonym of the UUID used in RedactPseudonym mode
+func (r *Redactor) Pseudonym(uuid UUID) (pseudonym UUID) {
+	h := hmac.New(sha256.New, r.opts.Key)
+	h.Write(uuid[:])
+	copy(pseudonym[:], h.Sum(nil))
+	pseudonym.setVersion(byte(r.opts.Version))
+	return
+}
+
+// RedactUUID returns the replacement for a single UUID in its canonical form
+func (r *Redactor) RedactUUID(uuid UUID) string {
+	switch r.opts.Mode {
+	case RedactPartial:
+		return uuid.String()[:8] + partialMask
+	case RedactPseudonym:
+		return r.Pseudonym(uuid).String()
+	default:
+		return r.opts.Mask
+	}
+}
+
+// Redact returns a copy of b with all UUIDs replaced
+func (r *Redactor) Redact(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	last := 0
+	for _, m := range FindAll(b) {
+		out = append(out, b[last:m.Start]...)
+		out = r.appendReplacement(out, b[m.Start:m.End], m.UUID)
+		last = m.End
+	}
+	return append(out, b[last:]...)
+}
+
+// RedactString returns a copy of s with all UUIDs replaced
+func (r *Redactor) RedactString(s string) string {
+	if len(s) < 32 {
+		return s
+	}
+	return string(r.Redact([]byte(s)))
+}
+
+// appendReplacement appends the replacement of the matched text to dst, keeping the form and case of the original
+func (r *Redactor) appendReplacement(dst, match []byte, uuid UUID) []byte {
+	if r.opts.Mode == RedactMask {
+		return append(dst, r.opts.Mask...)
+	}
+	repl := r.RedactUUID(uuid)
+	digits := match
+	switch len(match) {
+	case 38:
+		digits = match[1:37]
+	case len(urnPrefix) + 36:
+		digits = match[len(urnPrefix):]
+	}
+	if bytes.ContainsAny(digits, "ABCDEF") {
+		repl = strings.ToUpper(repl)
+	}
+	switch len(match) {
+	case 32:
+		return append(dst, strings.ReplaceAll(repl, "-", "")...)
+	case 38:
+		return append(append(append(dst, '{'), repl...), '}')
+	case len(urnPrefix) + 36:
+		return append(append(dst, match[:len(urnPrefix)]...), repl...)
+	default:
+		return append(dst, repl...)
+	}
+}
+
+// RedactWriter is an io.Writer that redacts UUIDs in the text written to it before passing it on.
+// Text is held back until a character that cannot be part of a UUID is written, so UUIDs split across writes are still found.
+// Without such a character, at most the last 44 bytes are held back, as no UUID is longer than its 45-byte URN form.
+// Flush must be called after the last write to pass on the remaining text.
+type RedactWriter struct {
+	r       *Redactor
+	w       io.Writer
+	buf     []byte
+	written int // number of bytes at the start of buf that were already written and are only kept as context
+}
+
+// Writer returns a RedactWriter passing redacted text on to w
+func (r *Redactor) Writer(w io.Writer) *RedactWriter {
+	return &RedactWriter{r: r, w: w}
+}
+
+// Write provides io.Writer
+func (w *RedactWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	// Text up to a separator can be redacted safely as no UUID can span it
+	cut := len(w.buf)
+	for cut > w.written && !isSeparator(w.buf[cut-1]) {
+		cut--
+	}
+	// Otherwise only the text that cannot be part of a UUID ending after it is passed on.
+	// Like ScanUUIDs, the text is cut at a word boundary, as no UUID can start within a word.
+	// A word that started before the written text is cut anywhere, as the byte kept before the cut rules out matches within it.
+	if cut == w.written && len(w.buf)-w.written >= maxMatchLength {
+		cut = len(w.buf) - (maxMatchLength - 1)
+		for cut > w.written && isAlnum(w.buf[cut-1]) && isAlnum(w.buf[cut]) {
+			cut--
+		}
+		if cut == w.written {
+			cut = len(w.buf) - (maxMatchLength - 1)
+		}
+	}
+	if cut == w.written {
+		return len(p), nil
+	}
+	if err := w.flush(cut); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush redacts and writes any text held back by Write
+func (w *RedactWriter) Flush() error {
+	if len(w.buf) == w.written {
+		w.buf, w.written = w.buf[:0], 0
+		return nil
+	}
+	err := w.flush(len(w.buf))
+	w.buf, w.written = w.buf[:0], 0
+	return err
+}
+
+// flush redacts and writes the text up to cut, extended to the end of a UUID spanning it unless the UUID could still change with more text.
+// The last byte written is kept at the start of buf, so UUIDs following it are recognized as they would be without the cut.
+func (w *RedactWriter) flush(cut int) error {
+	out := make([]byte, 0, cut-w.written)
+	last := w.written
+	for _, m := range FindAll(w.buf) {
+		if m.Start < w.written {
+			continue // starts at the byte kept as context, whose preceding byte is not available to rule it out
+		}
+		if m.Start >= cut {
+			break
+		}
+		if m.End > cut {
+			// A UUID ending before the end of the text cannot change with more text
+			if m.End == len(w.buf) {
+				cut = m.Start
+				break
+			}
+			cut = m.End
+		}
+		out = append(out, w.buf[last:m.Start]...)
+		out = w.r.appendReplacement(out, w.buf[m.Start:m.End], m.UUID)
+		last = m.End
+	}
+	if cut <= w.written {
+		return nil
+	}
+	out = append(out, w.buf[last:cut]...)
+	w.buf = append(w.buf[:0], w.buf[cut-1:]...)
+	w.written = 1
+	_, err := w.w.Write(out)
+	return err
+}
+
+// isSeparator reports whether c can neither be part of a UUID nor of a URN prefix or braces around one
+func isSeparator(c byte) bool {
+	return !isAlnum(c) && c != '-' && c != ':' && c != '{' && c != '}'
+}
+
+// Handler returns a LogHandler that replaces UUIDs using the Redactor before passing records on to next.
+// Besides UUID attributes, UUIDs within the message and string attributes are replaced as well.
+func (r *Redactor) Handler(next slog.Handler) *LogHandler {
+	return NewLogHandler(next, &LogHandlerOptions{Redactor: r})
+}
+
+var _ io.Writer = (*RedactWriter)(nil)
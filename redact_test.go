@@ -0,0 +1,175 @@
+package uuid
+
+import (
+	"bytes"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestNewRedactor(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    RedactorOptions
+		wantErr bool
+	}{
+		{"Mask", RedactorOptions{}, false},
+		{"Partial", RedactorOptions{Mode: RedactPartial}, false},
+		{"Pseudonym", RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret")}, false},
+		{"PseudonymV4", RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret"), Version: 4}, false},
+		{"PseudonymWithoutKey", RedactorOptions{Mode: RedactPseudonym}, true},
+		{"PseudonymInvalidVersion", RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret"), Version: 5}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRedactor(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewRedactor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedactor_Redact(t *testing.T) {
+	const input = "user=017f22e2-79b0-7cc3-98c4-dc0c0c07398f guid={017F22E2-79B0-7CC3-98C4-DC0C0C07398F} urn=urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f hex=017f22e279b07cc398c4dc0c0c07398f"
+	tests := []struct {
+		name string
+		opts RedactorOptions
+		want string
+	}{
+		{"Mask", RedactorOptions{}, "user=[REDACTED] guid=[REDACTED] urn=[REDACTED] hex=[REDACTED]"},
+		{"CustomMask", RedactorOptions{Mask: "***"}, "user=*** guid=*** urn=*** hex=***"},
+		{"Partial", RedactorOptions{Mode: RedactPartial}, "user=017f22e2-xxxx-xxxx-xxxx-xxxxxxxxxxxx guid={017F22E2-XXXX-XXXX-XXXX-XXXXXXXXXXXX} urn=urn:uuid:017f22e2-xxxx-xxxx-xxxx-xxxxxxxxxxxx hex=017f22e2xxxxxxxxxxxxxxxxxxxxxxxx"},
+		{"Pseudonym", RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret")}, "user=f6a8bac4-3928-8ba9-8706-cbadf2a1bd23 guid={F6A8BAC4-3928-8BA9-8706-CBADF2A1BD23} urn=urn:uuid:f6a8bac4-3928-8ba9-8706-cbadf2a1bd23 hex=f6a8bac439288ba98706cbadf2a1bd23"},
+		{"PseudonymV4", RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret"), Version: 4}, "user=f6a8bac4-3928-4ba9-8706-cbadf2a1bd23 guid={F6A8BAC4-3928-4BA9-8706-CBADF2A1BD23} urn=urn:uuid:f6a8bac4-3928-4ba9-8706-cbadf2a1bd23 hex=f6a8bac439284ba98706cbadf2a1bd23"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRedactor(tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := r.RedactString(input); got != tt.want {
+				t.Errorf("RedactString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactor_Pseudonym(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	a, _ := NewRedactor(RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret")})
+	b, _ := NewRedactor(RedactorOptions{Mode: RedactPseudonym, Key: []byte("other")})
+	if a.Pseudonym(id) != a.Pseudonym(id) {
+		t.Errorf("Pseudonym() is not deterministic")
+	}
+	if a.Pseudonym(id) == b.Pseudonym(id) {
+		t.Errorf("Pseudonym() does not depend on the key")
+	}
+	if p := a.Pseudonym(id); p.Version() != 8 || p.Variant() != VariantRFC9562 {
+		t.Errorf("Pseudonym() = %v, want version 8 and RFC 9562 variant", p)
+	}
+}
+
+func TestRedactWriter(t *testing.T) {
+	r, _ := NewRedactor(RedactorOptions{Mode: RedactPartial})
+	input := "a 017f22e2-79b0-7cc3-98c4-dc0c0c07398f\nb {017f22e2-79b0-7cc3-98c4-dc0c0c07398f}\nc urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	want := "a 017f22e2-xxxx-xxxx-xxxx-xxxxxxxxxxxx\nb {017f22e2-xxxx-xxxx-xxxx-xxxxxxxxxxxx}\nc urn:uuid:017f22e2-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	for _, size := range []int{1, 3, 16, len(input)} {
+		var out bytes.Buffer
+		w := r.Writer(&out)
+		for i := 0; i < len(input); i += size {
+			if _, err := w.Write([]byte(input[i:min(i+size, len(input))])); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != want {
+			t.Errorf("chunk size %d: output = %q, want %q", size, out.String(), want)
+		}
+	}
+}
+
+func TestRedactWriter_SingleLine(t *testing.T) {
+	r, _ := NewRedactor(RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret")})
+	input := strings.Repeat("urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f-{017F22E2-79B0-7CC3-98C4-DC0C0C07398F}-017f22e279b07cc398c4dc0c0c07398f-aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", 100)
+	want := r.RedactString(input)
+	for _, size := range []int{1, 7, 64, 1000} {
+		var out bytes.Buffer
+		w := r.Writer(&out)
+		for i := 0; i < len(input); i += size {
+			if _, err := w.Write([]byte(input[i:min(i+size, len(input))])); err != nil {
+				t.Fatal(err)
+			}
+			if len(w.buf) > maxMatchLength+size {
+				t.Fatalf("chunk size %d: %d bytes held back", size, len(w.buf))
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != want {
+			t.Errorf("chunk size %d: output = %q, want %q", size, out.String(), want)
+		}
+	}
+}
+
+func TestRedactWriter_HexRuns(t *testing.T) {
+	pieces := []string{
+		"-", "{", "}", ":", "urn:uuid:", "xxx",
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+		"f81d4fae7dec11d0a76500a0c91e6bf6",
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, mode := range []RedactMode{RedactMask, RedactPartial} {
+		r, _ := NewRedactor(RedactorOptions{Mode: mode})
+		inputs := []string{"xxxf81d4fae7dec11d0a76500a0c91e6bf6}urn:uuid:aaa"}
+		for range 200 {
+			var sb strings.Builder
+			for range 1 + rng.IntN(20) {
+				if rng.IntN(2) == 0 {
+					// Long hex runs without separators
+					for range rng.IntN(100) {
+						sb.WriteByte("0123456789abcdef"[rng.IntN(16)])
+					}
+				} else {
+					sb.WriteString(pieces[rng.IntN(len(pieces))])
+				}
+			}
+			inputs = append(inputs, sb.String())
+		}
+		for _, input := range inputs {
+			want := r.RedactString(input)
+			for _, size := range []int{1, 2, 5, 13, 1 + rng.IntN(60)} {
+				var out bytes.Buffer
+				w := r.Writer(&out)
+				for i := 0; i < len(input); i += size {
+					if _, err := w.Write([]byte(input[i:min(i+size, len(input))])); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := w.Flush(); err != nil {
+					t.Fatal(err)
+				}
+				if out.String() != want {
+					t.Errorf("mode %d, chunk size %d: output for %q = %q, want %q", mode, size, input, out.String(), want)
+				}
+			}
+		}
+	}
+}
+
+func TestRedactor_Handler(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	r, _ := NewRedactor(RedactorOptions{Mode: RedactPseudonym, Key: []byte("secret")})
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf, func(h slog.Handler) slog.Handler { return r.Handler(h) })
+	logger.With("user", id).Info("deleted 017f22e2-79b0-7cc3-98c4-dc0c0c07398f", "path", "/users/017f22e2-79b0-7cc3-98c4-dc0c0c07398f", slog.Group("req", Attr("id", id)))
+	want := `{"level":"INFO","msg":"deleted f6a8bac4-3928-8ba9-8706-cbadf2a1bd23","user":"f6a8bac4-3928-8ba9-8706-cbadf2a1bd23","path":"/users/f6a8bac4-3928-8ba9-8706-cbadf2a1bd23","req":{"id":"f6a8bac4-3928-8ba9-8706-cbadf2a1bd23"}}`
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("log output = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+package uuid
+
+import (
+	"bufio"
+	"io"
+)
+
+// maxMatchLength is the length of the longest text recognized as a UUID (a URN)
+const maxMatchLength = len(urnPrefix) + 36
+
+// Match is the position and value of a UUID found in text
+type Match struct {
+	Start int  // Offset of the first byte of the match
+	End   int  // Offset after the last byte of the match
+	UUID  UUID // The parsed UUID
+}
+
+// FindAll returns all UUIDs in b in the order they appear.
+// The recognized forms are described in Scanner. The range of a match includes surrounding braces or a URN prefix.
+func FindAll(b []byte) []Match {
+	var matches []Match
+	for offset := 0; ; {
+		start, end, uuid, ok := findUUID(b[offset:])
+		if !ok {
+			return matches
+		}
+		matches = append(matches, Match{offset + start, offset + end, uuid})
+		offset += end
+	}
+}
+
+// ScanUUIDs is a bufio.SplitFunc that returns each UUID in the input as a token, skipping all other text.
+// The recognized forms are described in Scanner. Tokens include surrounding braces or a URN prefix and can be parsed using FindAll.
+// Only a small amount of data is retained between calls, so the input may contain lines of any length.
+func ScanUUIDs(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	start, end, _, ok := findUUID(data)
+	if ok && (end < len(data) || atEOF) {
+		return end, data[start:end], nil
+	}
+	if atEOF {
+		return len(data), nil, nil
+	}
+	if ok {
+		return 0, nil, nil // The character following the match decides whether it is valid
+	}
+	// Matches that start earlier than cut are complete and have been ruled out, so the data before it can be discarded.
+	// The data must only be cut in a position where a match cannot start in the next call without a word boundary before it:
+	// either at a character that is not a letter or digit, or followed by a word long enough to rule out any match at its start.
+	cut := len(data) - 2*maxMatchLength
+	if cut <= 0 {
+		return 0, nil, nil
+	}
+	for i := cut; i > 0; i-- {
+		if !isAlnum(data[i]) {
+			return i, nil, nil
+		}
+	}
+	// The data up to cut is a single word, so keep only its last 37 characters
+	first := 0
+	if !isAlnum(data[0]) {
+		first = 1
+	}
+	run := 0
+	for first+run < len(data) && isAlnum(data[first+run]) {
+		run++
+	}
+	if run < 37 {
+		return 0, nil, nil
+	}
+	return first + run - 37, nil, nil
+}
+
+// Scanner finds UUIDs in a text stream such as a log file.
+// It recognizes the canonical form, the canonical form in braces, URNs (urn:uuid:<canonical>) and 32 hex digits without hyphens.
+// Matches must not be directly preceded or followed by a letter or digit, so UUIDs embedded in longer hex strings are ignored.
+// Since hashes are commonly written as 32 hex digits, the form without hyphens is only recognized for UUIDs of the RFC 9562 variant with a version between 1 and 8.
+type Scanner struct {
+	tokens *bufio.Scanner
+	uuid   UUID
+}
+
+// NewScanner returns a Scanner reading from r
+func NewScanner(r io.Reader) *Scanner {
+	tokens := bufio.NewScanner(r)
+	tokens.Split(ScanUUIDs)
+	return &Scanner{tokens: tokens}
+}
+
+// Scan advances to the next UUID in the input, which is then available through UUID.
+// It returns false when the end of the input is reached or an error occurred.
+func (s *Scanner) Scan() bool {
+	if !s.tokens.Scan() {
+		return false
+	}
+	_, _, s.uuid, _ = findUUID(s.tokens.Bytes())
+	return true
+}
+
+// UUID returns the UUID found by the last call to Scan
+func (s *Scanner) UUID() UUID {
+	return s.uuid
+}
+
+// Err returns the first error encountered while reading the input
+func (s *Scanner) Err() error {
+	return s.tokens.Err()
+}
+
+// findUUID returns the position and value of the first UUID in b.
+// The returned range includes surrounding braces or a URN prefix if present.
+func findUUID(b []byte) (start, end int, uuid UUID, ok bool) {
+	for i := 0; i < len(b); i++ {
+		if !isHex(b[i]) || (i > 0 && isAlnum(b[i-1])) {
+			continue
+		}
+		if i+36 <= len(b) && (i+36 == len(b) || !isAlnum(b[i+36])) && isCanonical(b[i:i+36]) {
+			uuid, _ = parseByteString(b[i : i+36])
+			start, end = i, i+36
+			if start > 0 && end < len(b) && b[start-1] == '{' && b[end] == '}' {
+				start, end = start-1, end+1
+			} else if start >= len(urnPrefix) && equalFoldASCII(b[start-len(urnPrefix):start], urnPrefix) {
+				start -= len(urnPrefix)
+			}
+			return start, end, uuid, true
+		}
+		if i+32 <= len(b) && (i+32 == len(b) || !isAlnum(b[i+32])) && allHex(b[i:i+32]) {
+			uuid = decodeHex(b[i : i+32])
+			if uuid.Variant() == VariantRFC9562 && uuid.Version() >= 1 && uuid.Version() <= 8 {
+				return i, i + 32, uuid, true
+			}
+		}
+		// Skip the rest of this word, no match can start within it
+		for i+1 < len(b) && isAlnum(b[i+1]) {
+			i++
+		}
+	}
+	return 0, 0, UUID{}, false
+}
+
+// isCanonical reports whether b consists of hex digits with hyphens at the positions of the canonical form
+func isCanonical(b []byte) bool {
+	for i, c := range b {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if c != '-' {
+				return false
+			}
+		} else if !isHex(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func allHex(b []byte) bool {
+	for _, c := range b {
+		if !isHex(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeHex decodes 32 hex digits that have already been validated
+func decodeHex(b []byte) (uuid UUID) {
+	for i := range uuid {
+		uuid[i] = unhex(b[2*i])<<4 | unhex(b[2*i+1])
+	}
+	return
+}
+
+func isAlnum(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// equalFoldASCII reports whether b equals the lowercase ASCII string s ignoring case
+func equalFoldASCII(b []byte, s string) bool {
+	for i := range b {
+		c := b[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != s[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package uuid
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	a := UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}
+	b := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	tests := []struct {
+		name  string
+		input string
+		want  []UUID
+	}{
+		{"Empty", "", nil},
+		{"Canonical", "request c232ab00-9414-11ec-b3c8-9f6bdeced846 done", []UUID{a}},
+		{"Uppercase", "C232AB00-9414-11EC-B3C8-9F6BDECED846", []UUID{a}},
+		{"Braces", "guid={C232AB00-9414-11EC-B3C8-9F6BDECED846}", []UUID{a}},
+		{"URN", "see URN:UUID:c232ab00-9414-11ec-b3c8-9f6bdeced846.", []UUID{a}},
+		{"Hex", "id=017f22e279b07cc398c4dc0c0c07398f,", []UUID{b}},
+		{"HexWrongVariant", "md5=017f22e279b07cc318c4dc0c0c07398f", nil},
+		{"Multiple", "c232ab00-9414-11ec-b3c8-9f6bdeced846 -> 017f22e2-79b0-7cc3-98c4-dc0c0c07398f\nagain c232ab00-9414-11ec-b3c8-9f6bdeced846", []UUID{a, b, a}},
+		{"Adjacent", "c232ab00-9414-11ec-b3c8-9f6bdeced846,017f22e2-79b0-7cc3-98c4-dc0c0c07398f", []UUID{a, b}},
+		{"Hyphenated", "x-c232ab00-9414-11ec-b3c8-9f6bdeced846-y", []UUID{a}},
+		{"Underscore", "user_c232ab00-9414-11ec-b3c8-9f6bdeced846_profile", []UUID{a}},
+		{"PrecededByAlnum", "xc232ab00-9414-11ec-b3c8-9f6bdeced846", nil},
+		{"FollowedByAlnum", "c232ab00-9414-11ec-b3c8-9f6bdeced846a", nil},
+		{"LongHex", "sha256=017f22e279b07cc398c4dc0c0c07398f017f22e279b07cc398c4dc0c0c07398f", nil},
+		{"InvalidCharacter", "c232ab00-9414-11ec-b3c8-9f6bdeced84g", nil},
+		{"Truncated", "c232ab00-9414-11ec-b3c8-9f6bdeced8", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScanner(strings.NewReader(tt.input))
+			var got []UUID
+			for s.Scan() {
+				got = append(got, s.UUID())
+			}
+			if err := s.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("read failed") }
+
+func TestScannerError(t *testing.T) {
+	s := NewScanner(errReader{})
+	if s.Scan() {
+		t.Fatalf("Scan() = true, want false")
+	}
+	if s.Err() == nil {
+		t.Errorf("Err() = nil, want error")
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	a := UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}
+	b := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	tests := []struct {
+		name  string
+		input string
+		want  []Match
+	}{
+		{"None", "nothing to see here", nil},
+		{"Canonical", "id=c232ab00-9414-11ec-b3c8-9f6bdeced846", []Match{{3, 39, a}}},
+		{"BracesAndURN", "{c232ab00-9414-11ec-b3c8-9f6bdeced846} urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f", []Match{{0, 38, a}, {39, 84, b}}},
+		{"Hex", "017f22e279b07cc398c4dc0c0c07398f c232ab00-9414-11ec-b3c8-9f6bdeced846", []Match{{0, 32, b}, {33, 69, a}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindAll([]byte(tt.input))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// chunkReader returns at most n bytes per read to exercise matches crossing buffer boundaries
+type chunkReader struct {
+	r io.Reader
+	n int
+}
+
+func (c chunkReader) Read(p []byte) (int, error) {
+	return c.r.Read(p[:min(len(p), c.n)])
+}
+
+func TestScanUUIDs(t *testing.T) {
+	var input strings.Builder
+	var want []string
+	forms := []string{"c232ab00-9414-11ec-b3c8-9f6bdeced846", "{017F22E2-79B0-7CC3-98C4-DC0C0C07398F}", "urn:uuid:c232ab00-9414-11ec-b3c8-9f6bdeced846", "017f22e279b07cc398c4dc0c0c07398f"}
+	for i := range 2000 {
+		// Separate matches by increasing amounts of text, including long words and hex strings that must not match
+		input.WriteString(strings.Repeat("x", i%7) + " ")
+		if i%5 == 0 {
+			input.WriteString(strings.Repeat("ab", 40+i%50) + " ")
+		}
+		if i%11 == 0 {
+			input.WriteString("q" + forms[0] + " " + forms[0] + "f ")
+		}
+		if i%13 == 0 {
+			input.WriteString(strings.Repeat("abcdef0123", 1000+i) + "-" + forms[1][1:37] + strings.Repeat("z", 500) + " ")
+		}
+		input.WriteString(forms[i%len(forms)] + " ")
+		want = append(want, forms[i%len(forms)])
+	}
+	for _, size := range []int{1, 7, 64, 4096} {
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			s := bufio.NewScanner(chunkReader{strings.NewReader(input.String()), size})
+			s.Split(ScanUUIDs)
+			var got []string
+			for s.Scan() {
+				got = append(got, s.Text())
+			}
+			if err := s.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %d tokens, want %d", len(got), len(want))
+				for i := range min(len(got), len(want)) {
+					if got[i] != want[i] {
+						t.Errorf("token %d = %q, want %q", i, got[i], want[i])
+						break
+					}
+				}
+			}
+		})
+	}
+}
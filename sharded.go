@@ -0,0 +1,107 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ShardedOptions configures a ShardedGenerator
+type ShardedOptions struct {
+	// ShardBits is the length of the shard ID following the timestamp and defaults to 16.
+	ShardBits int
+	// CounterBits is the length of a counter following the shard ID that is incremented for every UUID and wraps around when full.
+	// It defaults to 0, in which case all remaining bits are random.
+	CounterBits int
+}
+
+// ShardedGenerator creates time-ordered UUIDv8 with an embedded shard or region ID.
+// The UUIDs start with a 48-bit Unix timestamp in milliseconds like NewV7, followed by the shard ID,
+// an optional counter and random bits, so they sort by creation time while the shard stays readable from every ID.
+// A ShardedGenerator is safe for concurrent use.
+type ShardedGenerator struct {
+	shard       uint64
+	shardBits   uint
+	counterBits uint
+	counter     atomic.Uint64
+}
+
+// NewShardedGenerator returns a ShardedGenerator for the shard.
+// If opts is nil, the defaults described in ShardedOptions are used.
+// The shard ID must fit into ShardBits and the timestamp, shard ID and counter must leave at least 16 random bits.
+func NewShardedGenerator(shard uint64, opts *ShardedOptions) (*ShardedGenerator, error) {
+	var o ShardedOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.ShardBits == 0 {
+		o.ShardBits = 16
+	}
+	if o.ShardBits < 1 || o.ShardBits > 58 {
+		return nil, fmt.Errorf("shard ID length must be between 1 and 58 bits, got %d", o.ShardBits)
+	}
+	if o.CounterBits < 0 {
+		return nil, fmt.Errorf("counter length must not be negative, got %d", o.CounterBits)
+	}
+	if randomBits := payloadBits - 48 - o.ShardBits - o.CounterBits; randomBits < 16 {
+		return nil, fmt.Errorf("shard ID and counter leave %d random bits, need at least 16", randomBits)
+	}
+	if shard>>o.ShardBits != 0 {
+		return nil, fmt.Errorf("shard ID %d does not fit into %d bits", shard, o.ShardBits)
+	}
+	return &ShardedGenerator{shard: shard, shardBits: uint(o.ShardBits), counterBits: uint(o.CounterBits)}, nil
+}
+
+// New returns a new UUIDv8 based on the current timestamp
+func (g *ShardedGenerator) New() UUID {
+	return g.NewAt(currentTime())
+}
+
+// NewAt returns a new UUIDv8 based on the provided timestamp.
+// Timestamps before 1970 or after the year 10889, which cannot be represented, are clamped to the nearest representable time.
+func (g *ShardedGenerator) NewAt(t time.Time) UUID {
+	ms := min(max(t.UnixMilli(), 0), maxV7Millis)
+	var rnd [16]byte
+	randomSource(rnd[:])
+	hi, lo := binary.BigEndian.Uint64(rnd[:8]), binary.BigEndian.Uint64(rnd[8:])
+	setBits(&hi, &lo, payloadBits-48, 48, uint64(ms))
+	setBits(&hi, &lo, g.shardOffset(), g.shardBits, g.shard)
+	if g.counterBits > 0 {
+		setBits(&hi, &lo, g.shardOffset()-g.counterBits, g.counterBits, g.counter.Add(1)-1)
+	}
+	uuid := fromPayload(hi&(1<<58-1), lo)
+	uuid.setVersion(8)
+	return uuid
+}
+
+// ShardOf returns the shard ID embedded in a UUID created by a ShardedGenerator with the same options
+func (g *ShardedGenerator) ShardOf(uuid UUID) (uint64, error) {
+	if err := checkSharded(uuid); err != nil {
+		return 0, err
+	}
+	hi, lo := uuid.payload()
+	return getBits(hi, lo, g.shardOffset(), g.shardBits), nil
+}
+
+// Timestamp returns the creation time of a UUID created by a ShardedGenerator with millisecond precision
+func (g *ShardedGenerator) Timestamp(uuid UUID) (time.Time, error) {
+	if err := checkSharded(uuid); err != nil {
+		return time.Time{}, err
+	}
+	hi, lo := uuid.payload()
+	return time.UnixMilli(int64(getBits(hi, lo, payloadBits-48, 48))), nil
+}
+
+// shardOffset returns the offset of the least significant bit of the shard ID within the payload
+func (g *ShardedGenerator) shardOffset() uint {
+	return payloadBits - 48 - g.shardBits
+}
+
+func checkSharded(uuid UUID) error {
+	if uuid.Variant() != VariantRFC9562 || uuid.Version() != 8 {
+		return errors.New("expected UUID of version 8")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package uuid
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestNewShardedGenerator(t *testing.T) {
+	tests := []struct {
+		name    string
+		shard   uint64
+		opts    *ShardedOptions
+		wantErr bool
+	}{
+		{"Defaults", 65535, nil, false},
+		{"Counter", 3, &ShardedOptions{ShardBits: 10, CounterBits: 12}, false},
+		{"MaxShardBits", 1 << 57, &ShardedOptions{ShardBits: 58}, false},
+		{"ShardTooLarge", 65536, nil, true},
+		{"ShardBitsTooLong", 0, &ShardedOptions{ShardBits: 59}, true},
+		{"NegativeShardBits", 0, &ShardedOptions{ShardBits: -1}, true},
+		{"NegativeCounterBits", 0, &ShardedOptions{CounterBits: -1}, true},
+		{"TooFewRandomBits", 0, &ShardedOptions{ShardBits: 16, CounterBits: 43}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewShardedGenerator(tt.shard, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewShardedGenerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShardedGenerator(t *testing.T) {
+	testPrepare(testVecTimeRFC, bytes.Repeat([]byte{0xFF}, 64), 0, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB})
+	g, err := NewShardedGenerator(0xABC, &ShardedOptions{ShardBits: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := g.New()
+	want := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x8A, 0xBC, 0xBF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	if got != want {
+		t.Errorf("New() = %v, want %v", got, want)
+	}
+	if shard, err := g.ShardOf(got); err != nil || shard != 0xABC {
+		t.Errorf("ShardOf() = %x, %v, want abc", shard, err)
+	}
+	if ts, err := g.Timestamp(got); err != nil || !ts.Equal(time.UnixMilli(testVecTimeRFC/1e6)) {
+		t.Errorf("Timestamp() = %v, %v, want %v", ts, err, time.UnixMilli(testVecTimeRFC/1e6))
+	}
+	v7 := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	if _, err := g.ShardOf(v7); err == nil {
+		t.Errorf("ShardOf() of UUIDv7 did not return an error")
+	}
+	if _, err := g.Timestamp(v7); err == nil {
+		t.Errorf("Timestamp() of UUIDv7 did not return an error")
+	}
+}
+
+func TestShardedGenerator_Order(t *testing.T) {
+	g, err := NewShardedGenerator(42, &ShardedOptions{ShardBits: 10, CounterBits: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var prev UUID
+	for i := range 100 {
+		at := start.Add(time.Duration(i) * time.Millisecond)
+		id := g.NewAt(at)
+		if id.Version() != 8 || id.Variant() != VariantRFC9562 {
+			t.Errorf("NewAt() = %v, want version 8 and RFC 9562 variant", id)
+		}
+		if i > 0 && bytes.Compare(id[:], prev[:]) <= 0 {
+			t.Errorf("NewAt() = %v, not after %v", id, prev)
+		}
+		prev = id
+		if shard, _ := g.ShardOf(id); shard != 42 {
+			t.Errorf("ShardOf() = %d, want 42", shard)
+		}
+		if ts, _ := g.Timestamp(id); !ts.Equal(at) {
+			t.Errorf("Timestamp() = %v, want %v", ts, at)
+		}
+	}
+}
+
+func TestShardedGenerator_Clamp(t *testing.T) {
+	g, _ := NewShardedGenerator(7, nil)
+	tests := []struct {
+		name string
+		time time.Time
+		want time.Time
+	}{
+		{"Before1970", time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), time.UnixMilli(0)},
+		{"After10889", time.Date(10890, 1, 1, 0, 0, 0, 0, time.UTC), time.UnixMilli(maxV7Millis)},
+		{"Max", time.UnixMilli(maxV7Millis), time.UnixMilli(maxV7Millis)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := g.NewAt(tt.time)
+			if ts, err := g.Timestamp(id); err != nil || !ts.Equal(tt.want) {
+				t.Errorf("Timestamp(NewAt(%v)) = %v, %v, want %v", tt.time, ts, err, tt.want)
+			}
+			if shard, _ := g.ShardOf(id); shard != 7 {
+				t.Errorf("ShardOf() = %d, want 7", shard)
+			}
+		})
+	}
+}
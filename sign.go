@@ -0,0 +1,113 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+)
+
+// signerKeyIDBits is the number of bits of a signed UUID holding the key ID
+const signerKeyIDBits = 4
+
+// MaxSignerKeyID is the highest key ID supported by Signer
+const MaxSignerKeyID = 1<<signerKeyIDBits - 1
+
+// SignerOptions configures a Signer
+type SignerOptions struct {
+	// TagBits is the length of the authentication tag between 32 and 64 bits and defaults to 48.
+	// The payload takes up the remaining 118 - TagBits bits.
+	TagBits int
+	// Timestamp makes New start the payload with a 48-bit Unix timestamp in milliseconds like NewV7, so signed UUIDs sort by creation time.
+	// Otherwise the payload is random.
+	Timestamp bool
+}
+
+// Signer creates and verifies authenticated UUIDv8.
+// The 122 bits of a UUID besides version and variant are split into a payload, a 4-bit key ID and a tag
+// made up of the leading bits of the HMAC-SHA256 of the UUID with the tag set to zero.
+// Verification rejects forged or enumerated IDs without a database lookup, as creating a valid ID requires the key.
+// A Signer is safe for concurrent use.
+type Signer struct {
+	current int
+	keys    [MaxSignerKeyID + 1][]byte
+	tagBits uint
+	time    bool
+}
+
+// NewSigner returns a Signer using the provided options.
+// keys maps key IDs between 0 and MaxSignerKeyID to HMAC keys and current selects the key used for signing.
+// All keys can be used for verification, so keys can be rotated by adding a new key and making it current.
+// If opts is nil, the defaults described in SignerOptions are used.
+func NewSigner(current int, keys map[int][]byte, opts *SignerOptions) (*Signer, error) {
+	var o SignerOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.TagBits == 0 {
+		o.TagBits = 48
+	}
+	if o.TagBits < 32 || o.TagBits > 64 {
+		return nil, fmt.Errorf("tag length must be between 32 and 64 bits, got %d", o.TagBits)
+	}
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("current key ID %d has no key", current)
+	}
+	s := &Signer{current: current, tagBits: uint(o.TagBits), time: o.Timestamp}
+	for id, key := range keys {
+		if id < 0 || id > MaxSignerKeyID {
+			return nil, fmt.Errorf("key ID %d out of range 0 to %d", id, MaxSignerKeyID)
+		}
+		if len(key) == 0 {
+			return nil, fmt.Errorf("key %d is empty", id)
+		}
+		s.keys[id] = append([]byte(nil), key...)
+	}
+	return s, nil
+}
+
+// New returns a new signed UUIDv8 with a random or timestamp-based payload depending on the options of the Signer
+func (s *Signer) New() UUID {
+	var uuid UUID
+	if s.time {
+		uuid = NewV7()
+	} else {
+		randomSource(uuid[:])
+	}
+	return s.Sign(uuid)
+}
+
+// Sign returns a signed UUIDv8 with the payload of the provided UUID using the current key.
+// The payload consists of the leading 118 - TagBits bits of the UUID besides version and variant, all other bits are replaced.
+func (s *Signer) Sign(uuid UUID) UUID {
+	return s.sign(uuid, s.current)
+}
+
+// Verify reports whether the UUID was signed by a key known to the Signer.
+// The tag is compared in constant time.
+func (s *Signer) Verify(uuid UUID) bool {
+	hi, lo := uuid.payload()
+	id := int(getBits(hi, lo, s.tagBits, signerKeyIDBits))
+	if s.keys[id] == nil {
+		return false
+	}
+	want := s.sign(uuid, id)
+	return subtle.ConstantTimeCompare(uuid[:], want[:]) == 1
+}
+
+// sign sets key ID and version of the UUID and computes the tag using the key with the provided ID
+func (s *Signer) sign(uuid UUID, id int) UUID {
+	hi, lo := uuid.payload()
+	setBits(&hi, &lo, s.tagBits, signerKeyIDBits, uint64(id))
+	setBits(&hi, &lo, 0, s.tagBits, 0)
+	uuid = fromPayload(hi, lo)
+	uuid.setVersion(8)
+	h := hmac.New(sha256.New, s.keys[id])
+	h.Write(uuid[:])
+	tag := binary.BigEndian.Uint64(h.Sum(nil))
+	setBits(&hi, &lo, 0, s.tagBits, tag>>(64-s.tagBits))
+	uuid = fromPayload(hi, lo)
+	uuid.setVersion(8)
+	return uuid
+}
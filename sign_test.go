@@ -0,0 +1,102 @@
+package uuid
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestNewSigner(t *testing.T) {
+	key := []byte("secret")
+	tests := []struct {
+		name    string
+		current int
+		keys    map[int][]byte
+		opts    *SignerOptions
+		wantErr bool
+	}{
+		{"Defaults", 0, map[int][]byte{0: key}, nil, false},
+		{"TagBits32", 15, map[int][]byte{15: key}, &SignerOptions{TagBits: 32}, false},
+		{"TagBits64", 0, map[int][]byte{0: key}, &SignerOptions{TagBits: 64, Timestamp: true}, false},
+		{"TagBitsTooShort", 0, map[int][]byte{0: key}, &SignerOptions{TagBits: 31}, true},
+		{"TagBitsTooLong", 0, map[int][]byte{0: key}, &SignerOptions{TagBits: 65}, true},
+		{"MissingCurrent", 1, map[int][]byte{0: key}, nil, true},
+		{"KeyIDOutOfRange", 0, map[int][]byte{0: key, 16: key}, nil, true},
+		{"EmptyKey", 0, map[int][]byte{0: nil}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSigner(tt.current, tt.keys, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSigner(t *testing.T) {
+	payload := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	for _, tagBits := range []int{32, 48, 61, 64} {
+		s, err := NewSigner(9, map[int][]byte{9: []byte("secret")}, &SignerOptions{TagBits: tagBits})
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := s.Sign(payload)
+		if id.Version() != 8 || id.Variant() != VariantRFC9562 {
+			t.Errorf("TagBits %d: Sign() = %v, want version 8 and RFC 9562 variant", tagBits, id)
+		}
+		if !bytes.Equal(id[:6], payload[:6]) {
+			t.Errorf("TagBits %d: Sign() = %v, does not keep the payload of %v", tagBits, id, payload)
+		}
+		if !s.Verify(id) {
+			t.Errorf("TagBits %d: Verify(%v) = false, want true", tagBits, id)
+		}
+		if s.Sign(id) != id {
+			t.Errorf("TagBits %d: Sign() is not idempotent", tagBits)
+		}
+		// Every single bit flip must be detected
+		for i := range 128 {
+			forged := id
+			forged[i/8] ^= 0x80 >> (i % 8)
+			if s.Verify(forged) {
+				t.Errorf("TagBits %d: Verify() accepted %v with bit %d flipped", tagBits, forged, i)
+			}
+		}
+	}
+}
+
+func TestSigner_Rotation(t *testing.T) {
+	payload := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	old, _ := NewSigner(0, map[int][]byte{0: []byte("old")}, nil)
+	rotated, _ := NewSigner(1, map[int][]byte{0: []byte("old"), 1: []byte("new")}, nil)
+	other, _ := NewSigner(0, map[int][]byte{0: []byte("other")}, nil)
+	oldID, newID := old.Sign(payload), rotated.Sign(payload)
+	if oldID == newID {
+		t.Errorf("Sign() with different keys returned the same UUID")
+	}
+	if !rotated.Verify(oldID) || !rotated.Verify(newID) {
+		t.Errorf("Verify() rejected a UUID signed with a known key")
+	}
+	if old.Verify(newID) {
+		t.Errorf("Verify() accepted a UUID signed with an unknown key ID")
+	}
+	if other.Verify(oldID) {
+		t.Errorf("Verify() accepted a UUID signed with a different key")
+	}
+}
+
+func TestSigner_New(t *testing.T) {
+	testPrepare(testVecTimeRFC, bytes.Repeat([]byte{0xA5}, 32), 0, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB})
+	s, _ := NewSigner(0, map[int][]byte{0: []byte("secret")}, &SignerOptions{Timestamp: true})
+	id := s.New()
+	if !s.Verify(id) {
+		t.Errorf("Verify(New()) = false, want true")
+	}
+	if want := []byte{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0}; !bytes.Equal(id[:6], want) {
+		t.Errorf("New() = %v, want timestamp %x", id, want)
+	}
+	s, _ = NewSigner(0, map[int][]byte{0: []byte("secret")}, nil)
+	if id := s.New(); !s.Verify(id) || id[0] != 0xA5 {
+		t.Errorf("New() = %v, want verified UUID with random payload", id)
+	}
+}
@@ -0,0 +1,181 @@
+package uuid
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+// LogDetail controls how UUIDs are represented when logged using log/slog
+type LogDetail int32
+
+const (
+	LogDetailString LogDetail = iota // Log the canonical string representation only
+	LogDetailFull                    // Log a group containing the canonical string, version and timestamp if available
+)
+
+var logDetail atomic.Int32
+
+// SetLogDetail sets how UUIDs are represented when logged using log/slog.
+// The default is LogDetailString.
+func SetLogDetail(detail LogDetail) {
+	logDetail.Store(int32(detail))
+}
+
+// LogValue provides log/slog.LogValuer
+// Depending on the detail level set using SetLogDetail, the UUID is logged either as a string or as a group.
+func (uuid UUID) LogValue() slog.Value {
+	if LogDetail(logDetail.Load()) == LogDetailFull {
+		return uuid.logGroup()
+	}
+	return slog.StringValue(uuid.String())
+}
+
+// logGroup returns the UUID as a group containing the canonical string, version and timestamp if available
+func (uuid UUID) logGroup() slog.Value {
+	attrs := []slog.Attr{slog.String("id", uuid.String()), slog.Int("version", uuid.Version())}
+	if uuid.HasTimestamp() {
+		attrs = append(attrs, slog.Time("time", uuid.Timestamp().UTC()))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// detailedUUID is a UUID that is always logged as a group
+type detailedUUID UUID
+
+// LogValue provides log/slog.LogValuer
+func (uuid detailedUUID) LogValue() slog.Value {
+	return UUID(uuid).logGroup()
+}
+
+// Attr returns a log/slog.Attr for the UUID using the detail level set using SetLogDetail
+func Attr(key string, uuid UUID) slog.Attr {
+	return slog.Any(key, uuid)
+}
+
+// DetailedAttr returns a log/slog.Attr for the UUID containing a group with the canonical string, version and timestamp if available
+func DetailedAttr(key string, uuid UUID) slog.Attr {
+	return slog.Any(key, detailedUUID(uuid))
+}
+
+// LogPolicy controls how a LogHandler rewrites UUID attributes
+type LogPolicy int
+
+const (
+	LogPolicyKeep     LogPolicy = iota // Keep UUID attributes unchanged
+	LogPolicyRedact                    // Replace UUID attributes with a fixed placeholder
+	LogPolicyTruncate                  // Keep only a prefix of the canonical string representation
+)
+
+// redactedPlaceholder replaces UUIDs redacted by LogPolicyRedact
+const redactedPlaceholder = "[REDACTED]"
+
+// LogHandlerOptions configures a LogHandler
+type LogHandlerOptions struct {
+	// Policy controls how UUID attributes are rewritten
+	Policy LogPolicy
+	// Keep is the number of characters kept by LogPolicyTruncate and defaults to 8
+	Keep int
+	// Redactor replaces UUIDs instead of Policy if set.
+	// UUIDs within the message and string attributes are replaced as well.
+	Redactor *Redactor
+}
+
+// LogHandler is a log/slog.Handler middleware that redacts, truncates or pseudonymizes attributes containing a UUID before passing records on.
+// Attributes are considered UUIDs if their value is a UUID or *UUID or was created using Attr or DetailedAttr, including within groups.
+type LogHandler struct {
+	next slog.Handler
+	opts LogHandlerOptions
+}
+
+// NewLogHandler returns a LogHandler passing records to next after applying opts.
+// If opts is nil, LogPolicyRedact is used.
+func NewLogHandler(next slog.Handler, opts *LogHandlerOptions) *LogHandler {
+	h := &LogHandler{next: next, opts: LogHandlerOptions{Policy: LogPolicyRedact}}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Keep <= 0 {
+		h.opts.Keep = 8
+	}
+	return h
+}
+
+// Enabled provides log/slog.Handler
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle provides log/slog.Handler
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	msg := r.Message
+	if h.opts.Redactor != nil {
+		msg = h.opts.Redactor.RedactString(msg)
+	}
+	out := slog.NewRecord(r.Time, r.Level, msg, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.rewrite(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs provides log/slog.Handler
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	rewritten := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		rewritten[i] = h.rewrite(a)
+	}
+	return &LogHandler{next: h.next.WithAttrs(rewritten), opts: h.opts}
+}
+
+// WithGroup provides log/slog.Handler
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{next: h.next.WithGroup(name), opts: h.opts}
+}
+
+// rewrite applies the policy to an attribute if it contains a UUID
+func (h *LogHandler) rewrite(a slog.Attr) slog.Attr {
+	if a.Value.Kind() == slog.KindGroup {
+		group := a.Value.Group()
+		rewritten := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			rewritten[i] = h.rewrite(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(rewritten...)}
+	}
+	if a.Value.Kind() == slog.KindString && h.opts.Redactor != nil {
+		return slog.String(a.Key, h.opts.Redactor.RedactString(a.Value.String()))
+	}
+	if a.Value.Kind() != slog.KindAny && a.Value.Kind() != slog.KindLogValuer {
+		return a
+	}
+	var uuid UUID
+	switch v := a.Value.Any().(type) {
+	case UUID:
+		uuid = v
+	case detailedUUID:
+		uuid = UUID(v)
+	case *UUID:
+		if v == nil {
+			return slog.Any(a.Key, nil)
+		}
+		uuid = *v
+	default:
+		return a
+	}
+	if h.opts.Redactor != nil {
+		return slog.String(a.Key, h.opts.Redactor.RedactUUID(uuid))
+	}
+	switch h.opts.Policy {
+	case LogPolicyRedact:
+		return slog.String(a.Key, redactedPlaceholder)
+	case LogPolicyTruncate:
+		return slog.String(a.Key, uuid.String()[:min(h.opts.Keep, 36)])
+	default:
+		return a
+	}
+}
+
+var _ slog.LogValuer = (*UUID)(nil)
+var _ slog.Handler = (*LogHandler)(nil)
@@ -0,0 +1,81 @@
+package uuid
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// newTestLogger returns a logger writing JSON without timestamps to buf
+func newTestLogger(buf *bytes.Buffer, wrap func(slog.Handler) slog.Handler) *slog.Logger {
+	var h slog.Handler = slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	if wrap != nil {
+		h = wrap(h)
+	}
+	return slog.New(h)
+}
+
+func TestUUID_LogValue(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	v4 := UUID{0x91, 0x91, 0x08, 0xF7, 0x52, 0xD1, 0x43, 0x20, 0x9B, 0xAC, 0xF8, 0x47, 0xDB, 0x41, 0x48, 0xA8}
+	defer SetLogDetail(LogDetailString)
+
+	tests := []struct {
+		name   string
+		detail LogDetail
+		log    func(*slog.Logger)
+		want   string
+	}{
+		{"String", LogDetailString, func(l *slog.Logger) { l.Info("msg", "id", id) }, `{"level":"INFO","msg":"msg","id":"017f22e2-79b0-7cc3-98c4-dc0c0c07398f"}`},
+		{"Full", LogDetailFull, func(l *slog.Logger) { l.Info("msg", "id", id) }, `{"level":"INFO","msg":"msg","id":{"id":"017f22e2-79b0-7cc3-98c4-dc0c0c07398f","version":7,"time":"2022-02-22T19:22:22Z"}}`},
+		{"FullNoTimestamp", LogDetailFull, func(l *slog.Logger) { l.Info("msg", "id", v4) }, `{"level":"INFO","msg":"msg","id":{"id":"919108f7-52d1-4320-9bac-f847db4148a8","version":4}}`},
+		{"Attr", LogDetailString, func(l *slog.Logger) { l.Info("msg", Attr("id", id)) }, `{"level":"INFO","msg":"msg","id":"017f22e2-79b0-7cc3-98c4-dc0c0c07398f"}`},
+		{"DetailedAttr", LogDetailString, func(l *slog.Logger) { l.Info("msg", DetailedAttr("id", v4)) }, `{"level":"INFO","msg":"msg","id":{"id":"919108f7-52d1-4320-9bac-f847db4148a8","version":4}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLogDetail(tt.detail)
+			var buf bytes.Buffer
+			tt.log(newTestLogger(&buf, nil))
+			if got := strings.TrimSpace(buf.String()); got != tt.want {
+				t.Errorf("log output = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	id := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	tests := []struct {
+		name string
+		opts *LogHandlerOptions
+		log  func(*slog.Logger)
+		want string
+	}{
+		{"DefaultRedact", nil, func(l *slog.Logger) { l.Info("msg", "id", id, "other", "value") }, `{"level":"INFO","msg":"msg","id":"[REDACTED]","other":"value"}`},
+		{"Keep", &LogHandlerOptions{Policy: LogPolicyKeep}, func(l *slog.Logger) { l.Info("msg", "id", id) }, `{"level":"INFO","msg":"msg","id":"017f22e2-79b0-7cc3-98c4-dc0c0c07398f"}`},
+		{"Truncate", &LogHandlerOptions{Policy: LogPolicyTruncate}, func(l *slog.Logger) { l.Info("msg", "id", &id) }, `{"level":"INFO","msg":"msg","id":"017f22e2"}`},
+		{"TruncateKeep", &LogHandlerOptions{Policy: LogPolicyTruncate, Keep: 13}, func(l *slog.Logger) { l.Info("msg", Attr("id", id)) }, `{"level":"INFO","msg":"msg","id":"017f22e2-79b0"}`},
+		{"Group", nil, func(l *slog.Logger) { l.Info("msg", slog.Group("req", "id", id, "n", 1)) }, `{"level":"INFO","msg":"msg","req":{"id":"[REDACTED]","n":1}}`},
+		{"WithAttrs", nil, func(l *slog.Logger) { l.With("id", id).WithGroup("g").Info("msg", DetailedAttr("id", id)) }, `{"level":"INFO","msg":"msg","id":"[REDACTED]","g":{"id":"[REDACTED]"}}`},
+		{"NilPointer", nil, func(l *slog.Logger) { l.Info("msg", "id", (*UUID)(nil)) }, `{"level":"INFO","msg":"msg","id":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := newTestLogger(&buf, func(h slog.Handler) slog.Handler { return NewLogHandler(h, tt.opts) })
+			tt.log(logger)
+			if got := strings.TrimSpace(buf.String()); got != tt.want {
+				t.Errorf("log output = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,15 @@ package uuid
 
 import (
 	crand "crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"hash"
+	"io"
 	mrand "math/rand/v2"
 	"net"
+	"os"
+	"strings"
 	"time"
 )
 
@@ -23,6 +28,16 @@ var randomUint32 func(uint32) uint32 = mrand.Uint32N
 
 var netInterfaces func() ([]net.Interface, error) = net.Interfaces
 
+// readFile, hostname and lookupEnv are used to retrieve node identifiers from the system. They may be replaced for testing purposes.
+var (
+	readFile  func(string) ([]byte, error) = os.ReadFile
+	hostname  func() (string, error)       = os.Hostname
+	lookupEnv func(string) (string, bool)  = os.LookupEnv
+)
+
+// machineIDPaths lists the files checked by UseMachineID in order of preference.
+var machineIDPaths = []string{"/etc/machine-id", "/var/lib/dbus/machine-id"}
+
 var (
 	mac net.HardwareAddr // MAC address - derived from RandomMAC or from a network card if UseHardwareMAC is true
 )
@@ -62,6 +77,65 @@ func UseHardwareMAC() error {
 	return fmt.Errorf("no valid hardware MAC address found")
 }
 
+// hashedNode derives a node ID from the SHA-256 hash of salt and id.
+// The salt is prefixed with its length as a 64-bit big-endian unsigned integer, so moving bytes between salt and id changes the result.
+// The local and multicast bits are set so the node ID can never collide with a real MAC address.
+func hashedNode(salt []byte, id string) net.HardwareAddr {
+	hash := sha256.New()
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(salt)))
+	hash.Write(length[:])
+	hash.Write(salt)
+	hash.Write([]byte(id))
+	node := net.HardwareAddr(hash.Sum(nil)[:6])
+	node[0] |= 0x03 // set local and multicast bits - spec requires only multicast to be set
+	return node
+}
+
+// UseMachineID sets the node ID used for generating UUIDs to a hash of the system's machine ID and the provided salt.
+// The machine ID is read from /etc/machine-id or /var/lib/dbus/machine-id and is stable across restarts without identifying the hardware.
+// Use an application specific salt to prevent correlating node IDs across applications.
+// WARNING: This function is not thread-safe. Make sure to set the node ID before generating any UUIDs.
+func UseMachineID(salt []byte) error {
+	for _, path := range machineIDPaths {
+		data, err := readFile(path)
+		if err != nil {
+			continue
+		}
+		if id := strings.TrimSpace(string(data)); id != "" {
+			mac = hashedNode(salt, id)
+			return nil
+		}
+	}
+	return fmt.Errorf("no valid machine ID found")
+}
+
+// UseHostname sets the node ID used for generating UUIDs to a hash of the system's hostname and the provided salt.
+// WARNING: This function is not thread-safe. Make sure to set the node ID before generating any UUIDs.
+func UseHostname(salt []byte) error {
+	name, err := hostname()
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("hostname is empty")
+	}
+	mac = hashedNode(salt, name)
+	return nil
+}
+
+// UseEnvironment sets the node ID used for generating UUIDs to a hash of the value of the environment variable key and the provided salt.
+// This is useful in containerized environments where an identifier like the Kubernetes pod name is provided via the environment.
+// WARNING: This function is not thread-safe. Make sure to set the node ID before generating any UUIDs.
+func UseEnvironment(key string, salt []byte) error {
+	val, ok := lookupEnv(key)
+	if !ok || val == "" {
+		return fmt.Errorf("environment variable %s is not set", key)
+	}
+	mac = hashedNode(salt, val)
+	return nil
+}
+
 // UUID represents a Universal Unique Identifier as an array containing 16 bytes
 type UUID [16]byte
 
@@ -85,9 +159,55 @@ func (uuid UUID) Version() int {
 	return int(uuid[6] >> 4)
 }
 
-// Timestamp returns the timestamp of the UUID or nil if the UUID does not contain a timestamp
+// Variant represents the variant field of a UUID as defined in RFC 9562 Section 4.1
+type Variant byte
+
+// Variants as defined in RFC 9562 Section 4.1
+const (
+	VariantNCS       Variant = iota // Reserved for NCS backward compatibility (0b0xxx)
+	VariantRFC9562                  // Variant specified in RFC 9562 and RFC 4122 (0b10xx)
+	VariantMicrosoft                // Reserved for Microsoft backward compatibility (0b110x)
+	VariantFuture                   // Reserved for future definition (0b111x)
+)
+
+// String returns the name of the variant
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC9562:
+		return "RFC 9562"
+	case VariantMicrosoft:
+		return "Microsoft"
+	default:
+		return "Future"
+	}
+}
+
+// Variant returns the variant of the UUID
+func (uuid UUID) Variant() Variant {
+	switch {
+	case uuid[8]&0x80 == 0x00:
+		return VariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// Timestamp returns the timestamp of the UUID or the Unix epoch if the UUID does not contain a timestamp
+// Timestamps are available for UUIDv1, UUIDv6 (100ns precision) and UUIDv7 (millisecond precision).
 func (uuid UUID) Timestamp() time.Time {
 	switch uuid.Version() {
+	case 1:
+		t := int64(binary.BigEndian.Uint16(uuid[6:8])&0x0fff)<<48 | int64(binary.BigEndian.Uint16(uuid[4:6]))<<32 | int64(binary.BigEndian.Uint32(uuid[0:4]))
+		return intervalsToTime(t)
+	case 6:
+		t := int64(binary.BigEndian.Uint32(uuid[0:4]))<<28 | int64(binary.BigEndian.Uint16(uuid[4:6]))<<12 | int64(binary.BigEndian.Uint16(uuid[6:8])&0x0fff)
+		return intervalsToTime(t)
 	case 7:
 		i := int64(binary.BigEndian.Uint64(append([]byte{0x00, 0x00}, uuid[:6]...)))
 		return time.Unix(i/1000, (i%1000)*1000000)
@@ -96,10 +216,89 @@ func (uuid UUID) Timestamp() time.Time {
 	}
 }
 
+// HasTimestamp reports whether the UUID is of the RFC 9562 variant and a version embedding a timestamp that can be retrieved using Timestamp
+func (uuid UUID) HasTimestamp() bool {
+	if uuid.Variant() != VariantRFC9562 {
+		return false
+	}
+	switch uuid.Version() {
+	case 1, 6, 7:
+		return true
+	default:
+		return false
+	}
+}
+
+// intervalsToTime converts 100ns intervals since 1582-10-15T00:00:00.00Z to a time.Time
+func intervalsToTime(t int64) time.Time {
+	t -= epochToUnix
+	return time.Unix(t/10000000, (t%10000000)*100)
+}
+
+// newHashed returns a new name-based UUID of version v based on the hash of the provided namespace and name.
+func newHashed(hash hash.Hash, v byte, ns UUID, name []byte) (uuid UUID) {
+	hash.Write(ns[:])
+	hash.Write(name)
+	copy(uuid[:], hash.Sum(nil))
+	uuid.setVersion(v)
+	return
+}
+
+// newHashedReader returns a new name-based UUID of version v based on the hash of the provided namespace and the data read from r.
+// The data is streamed into the hash and never buffered as a whole.
+func newHashedReader(hash hash.Hash, v byte, ns UUID, r io.Reader) (uuid UUID, err error) {
+	hash.Write(ns[:])
+	if _, err = io.Copy(hash, r); err != nil {
+		return UUID{}, err
+	}
+	copy(uuid[:], hash.Sum(nil))
+	uuid.setVersion(v)
+	return
+}
+
+// newHashedParts returns a new name-based UUID of version v based on the hash of the provided namespace and name parts.
+// The number of parts and the length of each part are written as 64-bit big-endian unsigned integers so that the encoding is unambiguous,
+// also with regard to the names hashed by newHashed, which could otherwise equal the encoding of no parts:
+//
+//	hash(ns || len(parts) || len(parts[0]) || parts[0] || len(parts[1]) || parts[1] || ...)
+//
+// This encoding is part of the API and will not change as doing so would change the generated UUIDs.
+func newHashedParts(hash hash.Hash, v byte, ns UUID, parts [][]byte) (uuid UUID) {
+	hash.Write(ns[:])
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(parts)))
+	hash.Write(length[:])
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(length[:], uint64(len(part)))
+		hash.Write(length[:])
+		hash.Write(part)
+	}
+	copy(uuid[:], hash.Sum(nil))
+	uuid.setVersion(v)
+	return
+}
+
 func intervalsSinceEpoch() int64 {
 	return epochToUnix + currentTime().UTC().UnixNano()/100
 }
 
+// maxIntervals is the largest timestamp representable by the 60 timestamp bits of UUIDv1 and UUIDv6
+const maxIntervals = 1<<60 - 1
+
+// timeToIntervals converts t to 100ns intervals since 1582-10-15T00:00:00.00Z.
+// An error is returned if t does not fit into the 60 timestamp bits of UUIDv1 and UUIDv6, which cover the years 1582 to 5236.
+func timeToIntervals(t time.Time) (int64, error) {
+	secs := t.Unix() + epochToUnix/10000000
+	if secs < 0 || secs > maxIntervals/10000000 {
+		return 0, fmt.Errorf("time %v is outside the range of UUIDv1 and UUIDv6", t)
+	}
+	intervals := secs*10000000 + int64(t.Nanosecond()/100)
+	if intervals > maxIntervals {
+		return 0, fmt.Errorf("time %v is outside the range of UUIDv1 and UUIDv6", t)
+	}
+	return intervals, nil
+}
+
 func NamespaceDNS() UUID {
 	return UUID{0x6B, 0xA7, 0xB8, 0x10, 0x9D, 0xAD, 0x11, 0xD1, 0x80, 0xB4, 0x00, 0xC0, 0x4F, 0xD4, 0x30, 0xC8}
 }
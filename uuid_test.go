@@ -161,6 +161,143 @@ func TestUseHardwareMAC(t *testing.T) {
 	}
 }
 
+func TestUseMachineID(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+		wantMAC net.HardwareAddr
+	}{
+		{
+			name:    "Primary machine ID",
+			files:   map[string]string{"/etc/machine-id": "0123456789abcdef0123456789abcdef\n"},
+			wantErr: false,
+			wantMAC: net.HardwareAddr{0xFF, 0x99, 0x59, 0x67, 0xCD, 0x5C},
+		},
+		{
+			name:    "Fallback to D-Bus machine ID",
+			files:   map[string]string{"/etc/machine-id": "\n", "/var/lib/dbus/machine-id": "0123456789abcdef0123456789abcdef"},
+			wantErr: false,
+			wantMAC: net.HardwareAddr{0xFF, 0x99, 0x59, 0x67, 0xCD, 0x5C},
+		},
+		{
+			name:    "No machine ID",
+			files:   map[string]string{},
+			wantErr: true,
+			wantMAC: nil,
+		},
+	}
+
+	originalReadFile := readFile
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readFile = func(path string) ([]byte, error) {
+				if data, ok := tt.files[path]; ok {
+					return []byte(data), nil
+				}
+				return nil, errors.New("file not found")
+			}
+
+			defer func() {
+				readFile = originalReadFile
+			}()
+
+			err := UseMachineID([]byte("salt"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseMachineID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(mac, tt.wantMAC) {
+				t.Errorf("UseMachineID() did not set node ID correctly, got = %v, want %v", mac, tt.wantMAC)
+			}
+		})
+	}
+}
+
+func TestUseHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		hostErr  error
+		wantErr  bool
+		wantMAC  net.HardwareAddr
+	}{
+		{"Valid hostname", "host-1", nil, false, net.HardwareAddr{0x4B, 0x78, 0x0B, 0x3A, 0xA3, 0x8D}},
+		{"Empty hostname", "", nil, true, nil},
+		{"Error getting hostname", "", errors.New("hostname error"), true, nil},
+	}
+
+	originalHostname := hostname
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostname = func() (string, error) {
+				return tt.hostname, tt.hostErr
+			}
+
+			defer func() {
+				hostname = originalHostname
+			}()
+
+			err := UseHostname([]byte("salt"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseHostname() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(mac, tt.wantMAC) {
+				t.Errorf("UseHostname() did not set node ID correctly, got = %v, want %v", mac, tt.wantMAC)
+			}
+		})
+	}
+}
+
+func TestHashedNode(t *testing.T) {
+	if bytes.Equal(hashedNode([]byte("ab"), "c"), hashedNode([]byte("a"), "bc")) {
+		t.Errorf("hashedNode() does not separate salt and id")
+	}
+}
+
+func TestUseEnvironment(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+		wantMAC net.HardwareAddr
+	}{
+		{"Variable set", map[string]string{"POD_NAME": "pod-7f9c"}, false, net.HardwareAddr{0x23, 0xBC, 0xB0, 0x2B, 0x48, 0xB4}},
+		{"Variable empty", map[string]string{"POD_NAME": ""}, true, nil},
+		{"Variable missing", map[string]string{}, true, nil},
+	}
+
+	originalLookupEnv := lookupEnv
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookupEnv = func(key string) (string, bool) {
+				val, ok := tt.env[key]
+				return val, ok
+			}
+
+			defer func() {
+				lookupEnv = originalLookupEnv
+			}()
+
+			err := UseEnvironment("POD_NAME", nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UseEnvironment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(mac, tt.wantMAC) {
+				t.Errorf("UseEnvironment() did not set node ID correctly, got = %v, want %v", mac, tt.wantMAC)
+			}
+		})
+	}
+}
+
 func TestUUID_IsNil(t *testing.T) {
 	tests := []struct {
 		name string
@@ -237,6 +374,10 @@ func TestUUID_Timestamp(t *testing.T) {
 	}{
 		{"UUIDv7", UUID{0x01, 0x79, 0x75, 0x56, 0x0F, 0xBB, 0x70, 0x00, 0x81, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF}, time.Unix(1621171244, 987*1000000)},
 		{"UUIDv4", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, time.Unix(0, 0)},
+		{"UUIDv1", UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, time.Unix(0, testVecTimeRFC)},
+		{"UUIDv1Custom", UUID{0x85, 0x47, 0xD2, 0x3F, 0xB6, 0x49, 0x11, 0xEB, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, time.Unix(0, testVecTimeCustom/100*100)},
+		{"UUIDv6", UUID{0x1E, 0xC9, 0x41, 0x4C, 0x23, 0x2A, 0x6B, 0x00, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, time.Unix(0, testVecTimeRFC)},
+		{"UUIDv6Custom", UUID{0x1E, 0xBB, 0x64, 0x98, 0x54, 0x7D, 0x62, 0x3F, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, time.Unix(0, testVecTimeCustom/100*100)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -247,6 +388,47 @@ func TestUUID_Timestamp(t *testing.T) {
 	}
 }
 
+func TestUUID_HasTimestamp(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want bool
+	}{
+		{"UUIDv7", UUID{0x01, 0x79, 0x75, 0x56, 0x0F, 0xBB, 0x70, 0x00, 0x81, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF}, true},
+		{"UUIDv1", UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, true},
+		{"UUIDv6", UUID{0x1E, 0xC9, 0x41, 0x4C, 0x23, 0x2A, 0x6B, 0x00, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}, true},
+		{"UUIDv4", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, false},
+		{"NCSVersion7", UUID{0x01, 0x79, 0x75, 0x56, 0x0F, 0xBB, 0x70, 0x00, 0x61, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.HasTimestamp(); got != tt.want {
+				t.Errorf("UUID.HasTimestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_Variant(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want Variant
+	}{
+		{"NCS", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0x71, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, VariantNCS},
+		{"RFC9562", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xa1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, VariantRFC9562},
+		{"Microsoft", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xc1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, VariantMicrosoft},
+		{"Future", UUID{0x68, 0x6e, 0x77, 0x78, 0xf9, 0xf0, 0x46, 0x22, 0xe1, 0x3e, 0xc2, 0x44, 0x1c, 0xe4, 0xae, 0x41}, VariantFuture},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.Variant(); got != tt.want {
+				t.Errorf("UUID.Variant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_intervalsSinceEpoch(t *testing.T) {
 	tests := []struct {
 		name     string
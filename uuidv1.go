@@ -1,7 +1,10 @@
 package uuid
 
 import (
+	"fmt"
+	"net"
 	"sync/atomic"
+	"time"
 )
 
 var (
@@ -11,9 +14,30 @@ var (
 
 // NewV1 returns a new UUID based on the current timestamp and MAC address.
 // The timestamp is retrieved from the system clock.
-// The MAC address is randomly generated at application startup and can be overridden using SetMACAddress or UseHardwareMAC.
-func NewV1() (uuid UUID) {
-	timestamp := intervalsSinceEpoch()
+// The MAC address is randomly generated at application startup and can be overridden using SetMACAddress, UseHardwareMAC or one of the hashed node ID sources (UseMachineID, UseHostname, UseEnvironment).
+func NewV1() UUID {
+	return newV1(intervalsSinceEpoch(), mac)
+}
+
+// NewV1At returns a new UUIDv1 based on the provided timestamp and node.
+// If node is nil, the MAC address used by NewV1 is used instead. Otherwise it must be 6 bytes long.
+// An error is returned if the timestamp is before 1582-10-15 or after the year 5236, which UUIDv1 cannot represent.
+// The clock sequence is handled in the same way as for NewV1, so repeated calls with the same timestamp yield distinct UUIDs.
+func NewV1At(t time.Time, node net.HardwareAddr) (UUID, error) {
+	if node == nil {
+		node = mac
+	}
+	if len(node) != 6 {
+		return UUID{}, fmt.Errorf("node must be 6 bytes long, got %d", len(node))
+	}
+	timestamp, err := timeToIntervals(t)
+	if err != nil {
+		return UUID{}, err
+	}
+	return newV1(timestamp, node), nil
+}
+
+func newV1(timestamp int64, node net.HardwareAddr) (uuid UUID) {
 	uuid[0] = byte(timestamp >> 24) // time_low 32 bits from 0 to 31
 	uuid[1] = byte(timestamp >> 16)
 	uuid[2] = byte(timestamp >> 8)
@@ -31,7 +55,7 @@ func NewV1() (uuid UUID) {
 	}
 	uuid[8] = byte(seq >> 8) // clock_seq 14 bits from 66 to 79 (bits 64 and 65 are overwritten by variant)
 	uuid[9] = byte(seq >> 0)
-	copy(uuid[10:], mac) // node 48 bits from 80 to 127
+	copy(uuid[10:], node) // node 48 bits from 80 to 127
 	uuid.setVersion(1)
 	return
 }
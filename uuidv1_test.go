@@ -1,8 +1,11 @@
 package uuid
 
 import (
+	"bytes"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 )
 
 /*
@@ -89,3 +92,54 @@ func TestNewV1(t *testing.T) {
 		})
 	}
 }
+
+func TestNewV1At(t *testing.T) {
+	testPrepare(testVecTimeCustom, nil, 0x33C8, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB})
+	v1LastTimestamp.Store(0)
+	v1LastSequence.Store(0)
+	node := net.HardwareAddr{0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}
+	got, err := NewV1At(time.Unix(0, testVecTimeRFC), node)
+	if err != nil {
+		t.Fatalf("NewV1At() error = %v", err)
+	}
+	if want := (UUID{0xC2, 0x32, 0xAB, 0x00, 0x94, 0x14, 0x11, 0xEC, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}); got != want {
+		t.Errorf("NewV1At() = %v, want %v", got, want)
+	}
+	got, err = NewV1At(time.Unix(0, testVecTimeRFC), nil)
+	if err != nil {
+		t.Fatalf("NewV1At() error = %v", err)
+	}
+	if !bytes.Equal(got[10:], mac) {
+		t.Errorf("NewV1At() node = %x, want default %x", got[10:], mac)
+	}
+	if _, err := NewV1At(time.Unix(0, testVecTimeRFC), net.HardwareAddr{0x01}); err == nil {
+		t.Errorf("NewV1At() expected error for short node")
+	}
+}
+
+func TestNewV1At_Range(t *testing.T) {
+	gregorian := time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)
+	last := time.Unix(maxIntervals/10000000-epochToUnix/10000000, maxIntervals%10000000*100)
+	tests := []struct {
+		name    string
+		time    time.Time
+		wantErr bool
+	}{
+		{"Min", gregorian, false},
+		{"BeforeMin", gregorian.Add(-time.Nanosecond), true},
+		{"Max", last.Add(99), false},
+		{"AfterMax", last.Add(100), true},
+		{"FarFuture", time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewV1At(tt.time, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewV1At() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !got.Timestamp().Equal(tt.time.Truncate(100)) {
+				t.Errorf("NewV1At().Timestamp() = %v, want %v", got.Timestamp(), tt.time.Truncate(100))
+			}
+		})
+	}
+}
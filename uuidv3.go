@@ -2,14 +2,30 @@ package uuid
 
 import (
 	"crypto/md5"
+	"io"
 )
 
 // NewV3 returns a new UUID based on the MD5 hash of the provided namespace and name.
-func NewV3(ns UUID, name string) (uuid UUID) {
-	hash := md5.New()
-	hash.Write(ns[:])
-	hash.Write([]byte(name))
-	copy(uuid[:], hash.Sum(nil))
-	uuid.setVersion(3)
-	return
+func NewV3(ns UUID, name string) UUID {
+	return newHashed(md5.New(), 3, ns, []byte(name))
+}
+
+// NewV3Bytes returns a new UUID based on the MD5 hash of the provided namespace and binary name.
+func NewV3Bytes(ns UUID, name []byte) UUID {
+	return newHashed(md5.New(), 3, ns, name)
+}
+
+// NewV3Reader returns a new UUID based on the MD5 hash of the provided namespace and all data read from r.
+// The data is streamed into the hash, making it suitable for large inputs.
+// An error is returned if reading from r fails.
+func NewV3Reader(ns UUID, r io.Reader) (UUID, error) {
+	return newHashedReader(md5.New(), 3, ns, r)
+}
+
+// NewV3Parts returns a new UUID based on the MD5 hash of the provided namespace and a name made up of multiple parts.
+// The number of parts and the length of each part are hashed as 64-bit big-endian unsigned integers,
+// so composite names like ("ab", "c") and ("a", "bc") result in different UUIDs.
+// The result differs from NewV3 even for a single part or no parts at all.
+func NewV3Parts(ns UUID, parts ...[]byte) UUID {
+	return newHashedParts(md5.New(), 3, ns, parts)
 }
@@ -1,7 +1,10 @@
 package uuid
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 /*
@@ -33,3 +36,47 @@ func TestNewV3(t *testing.T) {
 		t.Errorf("uuid.NewV3() = %v, want %v", id, want)
 	}
 }
+
+func TestNewV3Bytes(t *testing.T) {
+	want := UUID{0x5D, 0xF4, 0x18, 0x81, 0x3A, 0xED, 0x35, 0x15, 0x88, 0xA7, 0x2F, 0x4A, 0x81, 0x4C, 0xF0, 0x9E}
+	id := NewV3Bytes(NamespaceDNS(), []byte("www.example.com"))
+	if id != want {
+		t.Errorf("uuid.NewV3Bytes() = %v, want %v", id, want)
+	}
+}
+
+func TestNewV3Reader(t *testing.T) {
+	want := UUID{0x5D, 0xF4, 0x18, 0x81, 0x3A, 0xED, 0x35, 0x15, 0x88, 0xA7, 0x2F, 0x4A, 0x81, 0x4C, 0xF0, 0x9E}
+	id, err := NewV3Reader(NamespaceDNS(), iotest.OneByteReader(strings.NewReader("www.example.com")))
+	if err != nil {
+		t.Fatalf("uuid.NewV3Reader() error = %v", err)
+	}
+	if id != want {
+		t.Errorf("uuid.NewV3Reader() = %v, want %v", id, want)
+	}
+	if _, err := NewV3Reader(NamespaceDNS(), iotest.ErrReader(errors.New("read error"))); err == nil {
+		t.Errorf("uuid.NewV3Reader() expected error for failing reader")
+	}
+}
+
+func TestNewV3Parts(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]byte
+		want  UUID
+	}{
+		{"AB_C", [][]byte{[]byte("ab"), []byte("c")}, UUID{0xDE, 0xDB, 0x8B, 0xCE, 0xAB, 0xF8, 0x34, 0xB8, 0x91, 0x93, 0xD0, 0x3A, 0x51, 0x47, 0x22, 0x6F}},
+		{"A_BC", [][]byte{[]byte("a"), []byte("bc")}, UUID{0xCF, 0x36, 0x01, 0x16, 0x20, 0x66, 0x3A, 0x32, 0x94, 0xFB, 0x7D, 0xFD, 0x3A, 0x74, 0xF5, 0xCB}},
+		{"NoParts", nil, UUID{0x40, 0xE4, 0x6E, 0x6B, 0x19, 0xF1, 0x3C, 0x02, 0x90, 0x96, 0x24, 0x8C, 0x62, 0xFB, 0xE9, 0xE6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if id := NewV3Parts(NamespaceDNS(), tt.parts...); id != tt.want {
+				t.Errorf("uuid.NewV3Parts() = %v, want %v", id, tt.want)
+			}
+		})
+	}
+	if NewV3Parts(NamespaceDNS()) == NewV3(NamespaceDNS(), "") {
+		t.Errorf("uuid.NewV3Parts() without parts equals NewV3 of an empty name")
+	}
+}
@@ -2,14 +2,30 @@ package uuid
 
 import (
 	"crypto/sha1"
+	"io"
 )
 
 // NewV5 returns a new UUID based on the SHA-1 hash of the provided namespace and name.
-func NewV5(ns UUID, name string) (uuid UUID) {
-	hash := sha1.New()
-	hash.Write(ns[:])
-	hash.Write([]byte(name))
-	copy(uuid[:], hash.Sum(nil))
-	uuid.setVersion(5)
-	return
+func NewV5(ns UUID, name string) UUID {
+	return newHashed(sha1.New(), 5, ns, []byte(name))
+}
+
+// NewV5Bytes returns a new UUID based on the SHA-1 hash of the provided namespace and binary name.
+func NewV5Bytes(ns UUID, name []byte) UUID {
+	return newHashed(sha1.New(), 5, ns, name)
+}
+
+// NewV5Reader returns a new UUID based on the SHA-1 hash of the provided namespace and all data read from r.
+// The data is streamed into the hash, making it suitable for large inputs.
+// An error is returned if reading from r fails.
+func NewV5Reader(ns UUID, r io.Reader) (UUID, error) {
+	return newHashedReader(sha1.New(), 5, ns, r)
+}
+
+// NewV5Parts returns a new UUID based on the SHA-1 hash of the provided namespace and a name made up of multiple parts.
+// The number of parts and the length of each part are hashed as 64-bit big-endian unsigned integers,
+// so composite names like ("ab", "c") and ("a", "bc") result in different UUIDs.
+// The result differs from NewV5 even for a single part or no parts at all.
+func NewV5Parts(ns UUID, parts ...[]byte) UUID {
+	return newHashedParts(sha1.New(), 5, ns, parts)
 }
@@ -1,7 +1,10 @@
 package uuid
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 /*
@@ -33,3 +36,47 @@ func TestNewV5(t *testing.T) {
 		t.Errorf("uuid.NewV5() = %v, want %v", id, want)
 	}
 }
+
+func TestNewV5Bytes(t *testing.T) {
+	want := UUID{0x2E, 0xD6, 0x65, 0x7D, 0xE9, 0x27, 0x56, 0x8B, 0x95, 0xE1, 0x26, 0x65, 0xA8, 0xAE, 0xA6, 0xA2}
+	id := NewV5Bytes(NamespaceDNS(), []byte("www.example.com"))
+	if id != want {
+		t.Errorf("uuid.NewV5Bytes() = %v, want %v", id, want)
+	}
+}
+
+func TestNewV5Reader(t *testing.T) {
+	want := UUID{0x2E, 0xD6, 0x65, 0x7D, 0xE9, 0x27, 0x56, 0x8B, 0x95, 0xE1, 0x26, 0x65, 0xA8, 0xAE, 0xA6, 0xA2}
+	id, err := NewV5Reader(NamespaceDNS(), iotest.OneByteReader(strings.NewReader("www.example.com")))
+	if err != nil {
+		t.Fatalf("uuid.NewV5Reader() error = %v", err)
+	}
+	if id != want {
+		t.Errorf("uuid.NewV5Reader() = %v, want %v", id, want)
+	}
+	if _, err := NewV5Reader(NamespaceDNS(), iotest.ErrReader(errors.New("read error"))); err == nil {
+		t.Errorf("uuid.NewV5Reader() expected error for failing reader")
+	}
+}
+
+func TestNewV5Parts(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]byte
+		want  UUID
+	}{
+		{"AB_C", [][]byte{[]byte("ab"), []byte("c")}, UUID{0xB2, 0xE9, 0xA3, 0x90, 0x2E, 0x09, 0x5C, 0xCF, 0x8C, 0xCF, 0x60, 0xEB, 0xF5, 0x25, 0x78, 0x4B}},
+		{"A_BC", [][]byte{[]byte("a"), []byte("bc")}, UUID{0xA1, 0xE4, 0x93, 0x56, 0xD2, 0xB6, 0x52, 0xCA, 0x90, 0xE7, 0x9A, 0x77, 0x25, 0xA4, 0xF5, 0x7E}},
+		{"NoParts", nil, UUID{0x76, 0x89, 0xA5, 0x87, 0xDC, 0x34, 0x58, 0x82, 0x9A, 0xEB, 0x93, 0x68, 0x59, 0x2F, 0x80, 0x5F}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if id := NewV5Parts(NamespaceDNS(), tt.parts...); id != tt.want {
+				t.Errorf("uuid.NewV5Parts() = %v, want %v", id, tt.want)
+			}
+		})
+	}
+	if NewV5Parts(NamespaceDNS()) == NewV5(NamespaceDNS(), "") {
+		t.Errorf("uuid.NewV5Parts() without parts equals NewV5 of an empty name")
+	}
+}
@@ -1,7 +1,10 @@
 package uuid
 
 import (
+	"fmt"
+	"net"
 	"sync/atomic"
+	"time"
 )
 
 var (
@@ -11,10 +14,31 @@ var (
 
 // NewV6 returns a new UUID based on the current timestamp and MAC address.
 // The timestamp is retrieved from the system clock.
-// The MAC address is randomly generated at application startup and can be overridden using SetMACAddress or UseHardwareMAC.
+// The MAC address is randomly generated at application startup and can be overridden using SetMACAddress, UseHardwareMAC or one of the hashed node ID sources (UseMachineID, UseHostname, UseEnvironment).
 // Unlike UUIDv1, UUIDv6 is designed to be sortable by time using binary or lexicographical comparison.
-func NewV6() (uuid UUID) {
-	timestamp := intervalsSinceEpoch()
+func NewV6() UUID {
+	return newV6(intervalsSinceEpoch(), mac)
+}
+
+// NewV6At returns a new UUIDv6 based on the provided timestamp and node.
+// If node is nil, the MAC address used by NewV6 is used instead. Otherwise it must be 6 bytes long.
+// An error is returned if the timestamp is before 1582-10-15 or after the year 5236, which UUIDv6 cannot represent.
+// The clock sequence is handled in the same way as for NewV6, so repeated calls with the same timestamp yield distinct UUIDs.
+func NewV6At(t time.Time, node net.HardwareAddr) (UUID, error) {
+	if node == nil {
+		node = mac
+	}
+	if len(node) != 6 {
+		return UUID{}, fmt.Errorf("node must be 6 bytes long, got %d", len(node))
+	}
+	timestamp, err := timeToIntervals(t)
+	if err != nil {
+		return UUID{}, err
+	}
+	return newV6(timestamp, node), nil
+}
+
+func newV6(timestamp int64, node net.HardwareAddr) (uuid UUID) {
 	uuid[0] = byte(timestamp >> 52) // time_high 32 bits from 0 to 31
 	uuid[1] = byte(timestamp >> 44)
 	uuid[2] = byte(timestamp >> 36)
@@ -32,7 +56,7 @@ func NewV6() (uuid UUID) {
 	}
 	uuid[8] = byte(seq >> 8) // clock_seq 14 bits from 66 to 79 (bits 64 and 65 are overwritten by variant)
 	uuid[9] = byte(seq >> 0)
-	copy(uuid[10:], mac) // node 48 bits from 80 to 127
+	copy(uuid[10:], node) // node 48 bits from 80 to 127
 	uuid.setVersion(6)
 	return
 }
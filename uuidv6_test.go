@@ -1,8 +1,11 @@
 package uuid
 
 import (
+	"bytes"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 )
 
 /*
@@ -89,3 +92,54 @@ func TestNewV6(t *testing.T) {
 		})
 	}
 }
+
+func TestNewV6At(t *testing.T) {
+	testPrepare(testVecTimeCustom, nil, 0x33C8, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xAB})
+	v6LastTimestamp.Store(0)
+	v6LastSequence.Store(0)
+	node := net.HardwareAddr{0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}
+	got, err := NewV6At(time.Unix(0, testVecTimeRFC), node)
+	if err != nil {
+		t.Fatalf("NewV6At() error = %v", err)
+	}
+	if want := (UUID{0x1E, 0xC9, 0x41, 0x4C, 0x23, 0x2A, 0x6B, 0x00, 0xB3, 0xC8, 0x9F, 0x6B, 0xDE, 0xCE, 0xD8, 0x46}); got != want {
+		t.Errorf("NewV6At() = %v, want %v", got, want)
+	}
+	got, err = NewV6At(time.Unix(0, testVecTimeRFC), nil)
+	if err != nil {
+		t.Fatalf("NewV6At() error = %v", err)
+	}
+	if !bytes.Equal(got[10:], mac) {
+		t.Errorf("NewV6At() node = %x, want default %x", got[10:], mac)
+	}
+	if _, err := NewV6At(time.Unix(0, testVecTimeRFC), net.HardwareAddr{0x01}); err == nil {
+		t.Errorf("NewV6At() expected error for short node")
+	}
+}
+
+func TestNewV6At_Range(t *testing.T) {
+	gregorian := time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)
+	last := time.Unix(maxIntervals/10000000-epochToUnix/10000000, maxIntervals%10000000*100)
+	tests := []struct {
+		name    string
+		time    time.Time
+		wantErr bool
+	}{
+		{"Min", gregorian, false},
+		{"BeforeMin", gregorian.Add(-time.Nanosecond), true},
+		{"Max", last.Add(99), false},
+		{"AfterMax", last.Add(100), true},
+		{"FarFuture", time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewV6At(tt.time, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewV6At() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !got.Timestamp().Equal(tt.time.Truncate(100)) {
+				t.Errorf("NewV6At().Timestamp() = %v, want %v", got.Timestamp(), tt.time.Truncate(100))
+			}
+		})
+	}
+}
@@ -1,12 +1,33 @@
 package uuid
 
+import (
+	"fmt"
+	"time"
+)
+
+// maxV7Millis is the largest Unix timestamp in milliseconds representable by the 48 timestamp bits of UUIDv7
+const maxV7Millis = 1<<48 - 1
+
 // NewV7 returns a new UUID based on the current timestamp and random data.
 // The timestamp is retrieved from the system clock.
 // The random data is generated using the cryptographically secure random number generator.
 // This implementation uses the fractional millisecond approach for ordering of UUIDs within the same millisecond.
-func NewV7() (uuid UUID) {
-	time := currentTime()
-	ms := time.UnixMilli()
+func NewV7() UUID {
+	return newV7(currentTime())
+}
+
+// NewV7At returns a new UUIDv7 based on the provided timestamp and random data.
+// It can be used to generate UUIDs for past or future events.
+// An error is returned if the timestamp is before 1970 or after the year 10889, which UUIDv7 cannot represent.
+func NewV7At(t time.Time) (UUID, error) {
+	if t.Unix() < 0 || t.Unix() > maxV7Millis/1000 || t.UnixMilli() > maxV7Millis {
+		return UUID{}, fmt.Errorf("time %v is outside the range of UUIDv7", t)
+	}
+	return newV7(t), nil
+}
+
+func newV7(t time.Time) (uuid UUID) {
+	ms := t.UnixMilli()
 	uuid[0] = byte(ms >> 40) //1-6 bytes: 48-bit big-endian unsigned number of Unix epoch timestamp
 	uuid[1] = byte(ms >> 32)
 	uuid[2] = byte(ms >> 24)
@@ -14,7 +35,7 @@ func NewV7() (uuid UUID) {
 	uuid[4] = byte(ms >> 8)
 	uuid[5] = byte(ms)
 
-	frac := uint16(time.Nanosecond() % 1000000 * 4095 / 999999)
+	frac := uint16(t.Nanosecond() % 1000000 * 4095 / 999999)
 
 	uuid[6] = byte(frac >> 8) //7-8 bytes: 12-bit big-endian fractional part of Unix epoch timestamp
 	uuid[7] = byte(frac)
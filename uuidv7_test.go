@@ -3,6 +3,7 @@ package uuid
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 /*
@@ -68,3 +69,40 @@ func TestNewV7(t *testing.T) {
 		})
 	}
 }
+
+func TestNewV7At(t *testing.T) {
+	testPrepare(testVecTimeCustom, []byte{0x18, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}, 0, nil)
+	got, err := NewV7At(time.Unix(0, testVecTimeRFC+(0xCC3*999999+4095)/4095))
+	if err != nil {
+		t.Fatalf("NewV7At() error = %v", err)
+	}
+	want := UUID{0x01, 0x7F, 0x22, 0xE2, 0x79, 0xB0, 0x7C, 0xC3, 0x98, 0xC4, 0xDC, 0x0C, 0x0C, 0x07, 0x39, 0x8F}
+	if got != want {
+		t.Errorf("NewV7At() = %v, want %v", got, want)
+	}
+}
+
+func TestNewV7At_Range(t *testing.T) {
+	tests := []struct {
+		name    string
+		time    time.Time
+		wantErr bool
+	}{
+		{"Min", time.Unix(0, 0), false},
+		{"BeforeMin", time.Unix(0, -1), true},
+		{"Max", time.UnixMilli(maxV7Millis).Add(999999), false},
+		{"AfterMax", time.UnixMilli(maxV7Millis + 1), true},
+		{"FarFuture", time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewV7At(tt.time)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewV7At() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !got.Timestamp().Equal(tt.time.Truncate(time.Millisecond)) {
+				t.Errorf("NewV7At().Timestamp() = %v, want %v", got.Timestamp(), tt.time.Truncate(time.Millisecond))
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package uuid
 
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+)
+
 // NewV8 returns a new UUID based on the provided data.
 // The data must be 16 bytes long.
 // Bits 48-51 of the UUID are set to 0b1000 (version 8).
@@ -9,3 +15,26 @@ func NewV8(data []byte) (uuid UUID) {
 	uuid.setVersion(8)
 	return
 }
+
+// NewV8Hash returns a new name-based UUIDv8 using the hash function returned by newHash.
+// The namespace and name are hashed in the same way as for NewV3 and NewV5 and the first 16 bytes of the hash are used.
+// The hash function must produce at least 16 bytes of output.
+func NewV8Hash(newHash func() hash.Hash, ns UUID, name string) UUID {
+	return newHashed(newHash(), 8, ns, []byte(name))
+}
+
+// NewV8SHA256 returns a new name-based UUIDv8 based on the SHA-256 hash of the provided namespace and name as described in RFC 9562 Appendix B.2.
+func NewV8SHA256(ns UUID, name string) UUID {
+	return newHashed(sha256.New(), 8, ns, []byte(name))
+}
+
+// NewV8SHA256Parts returns a new name-based UUIDv8 based on the SHA-256 hash of the provided namespace and a name made up of multiple parts.
+// Parts are encoded in the same way as for NewV5Parts.
+func NewV8SHA256Parts(ns UUID, parts ...[]byte) UUID {
+	return newHashedParts(sha256.New(), 8, ns, parts)
+}
+
+// NewV8SHA512 returns a new name-based UUIDv8 based on the SHA-512 hash of the provided namespace and name.
+func NewV8SHA512(ns UUID, name string) UUID {
+	return newHashed(sha512.New(), 8, ns, []byte(name))
+}
@@ -1,6 +1,9 @@
 package uuid
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
 	"testing"
 )
 
@@ -12,3 +15,82 @@ func TestNewV8(t *testing.T) {
 		t.Errorf("uuid.NewV8() = %v, want %v", id, want)
 	}
 }
+
+/*
+RFC 9562 B.2. Example of a UUIDv8 Value (Name-Based)
+
+Namespace (DNS):  6ba7b810-9dad-11d1-80b4-00c04fd430c8
+Name:             www.example.com
+----------------------------------------------------------------
+SHA-256:          5c146b143c524afd938a375d0df1fbf6fe12a66b645f72f6158759387e51f3c8
+-------------------------------------------
+field     bits value
+-------------------------------------------
+custom_a  48   0x5c146b143c52
+ver        4   0x8
+custom_b  12   0xafd
+var        2   0b10
+custom_c  62   0b01, 0x38a375d0df1fbf6
+-------------------------------------------
+total     128
+-------------------------------------------
+final: 5c146b14-3c52-8afd-938a-375d0df1fbf6
+*/
+
+func TestNewV8SHA256(t *testing.T) {
+	want := UUID{0x5C, 0x14, 0x6B, 0x14, 0x3C, 0x52, 0x8A, 0xFD, 0x93, 0x8A, 0x37, 0x5D, 0x0D, 0xF1, 0xFB, 0xF6}
+	id := NewV8SHA256(NamespaceDNS(), "www.example.com")
+
+	if id != want {
+		t.Errorf("uuid.NewV8SHA256() = %v, want %v", id, want)
+	}
+}
+
+func TestNewV8SHA512(t *testing.T) {
+	want := UUID{0x94, 0xEE, 0x4D, 0xDB, 0x9F, 0x36, 0x80, 0x18, 0x9C, 0xCF, 0x86, 0xA4, 0x44, 0x16, 0x91, 0xE0}
+	id := NewV8SHA512(NamespaceDNS(), "www.example.com")
+
+	if id != want {
+		t.Errorf("uuid.NewV8SHA512() = %v, want %v", id, want)
+	}
+}
+
+func TestNewV8Hash(t *testing.T) {
+	tests := []struct {
+		name    string
+		newHash func() hash.Hash
+		want    UUID
+	}{
+		{"SHA-256", sha256.New, NewV8SHA256(NamespaceDNS(), "www.example.com")},
+		{"SHA-512", sha512.New, NewV8SHA512(NamespaceDNS(), "www.example.com")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if id := NewV8Hash(tt.newHash, NamespaceDNS(), "www.example.com"); id != tt.want {
+				t.Errorf("uuid.NewV8Hash() = %v, want %v", id, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewV8SHA256Parts(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]byte
+		want  UUID
+	}{
+		{"AB_C", [][]byte{[]byte("ab"), []byte("c")}, UUID{0x05, 0xF2, 0xB0, 0x31, 0x4C, 0xA5, 0x82, 0xE3, 0x92, 0x77, 0xFA, 0x6F, 0x37, 0x98, 0x37, 0xFF}},
+		{"A_BC", [][]byte{[]byte("a"), []byte("bc")}, UUID{0x8D, 0xD9, 0x73, 0x00, 0x2B, 0xE4, 0x8A, 0xD0, 0xAB, 0xD5, 0xEB, 0x30, 0x10, 0x0B, 0x3D, 0x77}},
+		{"NoParts", nil, UUID{0xE1, 0x5B, 0x4B, 0x91, 0x74, 0x8B, 0x8D, 0x63, 0xBB, 0x1E, 0x71, 0x56, 0xBD, 0xFD, 0x06, 0x7C}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if id := NewV8SHA256Parts(NamespaceDNS(), tt.parts...); id != tt.want {
+				t.Errorf("uuid.NewV8SHA256Parts() = %v, want %v", id, tt.want)
+			}
+		})
+	}
+	if NewV8SHA256Parts(NamespaceDNS()) == NewV8SHA256(NamespaceDNS(), "") {
+		t.Errorf("uuid.NewV8SHA256Parts() without parts equals NewV8SHA256 of an empty name")
+	}
+}